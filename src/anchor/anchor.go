@@ -0,0 +1,132 @@
+package anchor
+
+// MaxErrorRatio is the share of a quote that may differ from the text it is found at
+const MaxErrorRatio = 0.2
+
+// MaxQuoteLength is the largest number of characters a quote may have
+const MaxQuoteLength = 1000
+
+// MaxComparisons is the largest number of quote and text character pairs compared when
+// searching for an edited quote, so that anchoring cannot take time quadratic in the text.
+// Quotes that would need more are only found where they occur exactly
+const MaxComparisons = 1 << 22
+
+// Range is a span of a text in characters, End exclusive
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// cell is a state of the fuzzy match: the edits so far and where the match started
+type cell struct {
+	cost  int
+	start int
+}
+
+// Locate finds a quote in a text. An exact occurrence closest to hint wins;
+// without one, the span that differs least from the quote does,
+// as long as at most MaxErrorRatio of the quote was edited and the search stays within MaxComparisons
+func Locate(text string, quote string, hint int) (Range, bool) {
+	t := []rune(text)
+	q := []rune(quote)
+	if len(q) == 0 || len(q) > len(t)+int(float64(len(q))*MaxErrorRatio) {
+		return Range{}, false
+	}
+
+	if r, ok := locateExact(t, q, hint); ok {
+		return r, true
+	}
+	return locateFuzzy(t, q, hint)
+}
+
+// Valid reports whether a range lies within a text of the given length
+func (r Range) Valid(length int) bool {
+	return r.Start >= 0 && r.Start < r.End && r.End <= length
+}
+
+// Slice returns the characters of a text in the range
+func (r Range) Slice(text string) string {
+	return string([]rune(text)[r.Start:r.End])
+}
+
+func locateExact(t []rune, q []rune, hint int) (Range, bool) {
+	best := -1
+	for i := 0; i+len(q) <= len(t); i++ {
+		if equalRunes(t[i:i+len(q)], q) && (best < 0 || distance(i, hint) < distance(best, hint)) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return Range{}, false
+	}
+	return Range{best, best + len(q)}, true
+}
+
+// locateFuzzy computes the edit distance of the quote to every span of the text at once,
+// letting a match start anywhere for free
+func locateFuzzy(t []rune, q []rune, hint int) (Range, bool) {
+	maxErrors := int(float64(len(q)) * MaxErrorRatio)
+	if maxErrors == 0 || len(q)*len(t) > MaxComparisons {
+		return Range{}, false
+	}
+
+	// prev[i] is the best match of q[:i] ending before the current character
+	prev := make([]cell, len(q)+1)
+	cur := make([]cell, len(q)+1)
+	for i := range prev {
+		prev[i] = cell{i, 0}
+	}
+
+	var best Range
+	bestCost := maxErrors + 1
+	for j := range t {
+		cur[0] = cell{0, j + 1}
+		for i := 1; i <= len(q); i++ {
+			substitution := prev[i-1]
+			if q[i-1] != t[j] {
+				substitution.cost++
+			}
+			skipText := cell{prev[i].cost + 1, prev[i].start}
+			skipQuote := cell{cur[i-1].cost + 1, cur[i-1].start}
+			cur[i] = cheapest(substitution, skipText, skipQuote)
+		}
+
+		end := cur[len(q)]
+		candidate := Range{end.start, j + 1}
+		if end.cost < bestCost || (end.cost == bestCost && distance(candidate.Start, hint) < distance(best.Start, hint)) {
+			best, bestCost = candidate, end.cost
+		}
+		prev, cur = cur, prev
+	}
+
+	if bestCost > maxErrors || best.Start >= best.End {
+		return Range{}, false
+	}
+	return best, true
+}
+
+func cheapest(cells ...cell) cell {
+	best := cells[0]
+	for _, c := range cells[1:] {
+		if c.cost < best.cost {
+			best = c
+		}
+	}
+	return best
+}
+
+func equalRunes(a []rune, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func distance(a int, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,269 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// GetCoAuthors godoc
+// @Summary Get the co-authors of a post
+// @Description Retrieve the co-authors of a post in byline order with pending invitations. Only the primary author sees them
+// @Tags posts
+// @ID get-co-authors
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {object} api.SwaggerCoAuthors
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/authors [get]
+func GetCoAuthors(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		post, ok := findOwnPost(c, pool)
+		if !ok {
+			return
+		}
+
+		coAuthors, err := db.GetCoAuthors(c, pool, post.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve authors from DB.")
+			return
+		}
+
+		serialized := make([]response, len(coAuthors))
+		for i, a := range coAuthors {
+			serialized[i] = serializeCoAuthor(a)
+		}
+		c.JSON(http.StatusOK, response{"authors": serialized})
+	}
+}
+
+// InviteCoAuthor godoc
+// @Summary Invite a co-author
+// @Description Invites a user to co-author a post. Only the primary author invites co-authors
+// @Tags posts
+// @ID invite-co-author
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param author body api.CoAuthorForm true "User to invite"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/authors [post]
+func InviteCoAuthor(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody CoAuthorForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "User ID required.")
+			return
+		}
+
+		post, ok := findOwnPost(c, pool)
+		if !ok {
+			return
+		}
+
+		user, err := db.GetUserByID(c, pool, int64(reqBody.UserID))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.InviteCoAuthor(c, pool, post, user); err == db.ErrAlreadyAuthor {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to invite author in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// AcceptCoAuthorship godoc
+// @Summary Accept an invitation
+// @Description Accepts the invitation of the current user to co-author a post, adding them to its bylines
+// @Tags posts
+// @ID accept-co-authorship
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/authors/accept [post]
+func AcceptCoAuthorship(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.AcceptCoAuthorship(c, pool, int(id), user.ID, time.Now()); err == sql.ErrNoRows {
+			HandleError(c, http.StatusBadRequest, "Invitation not found.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to accept invitation in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// RemoveCoAuthor godoc
+// @Summary Remove a co-author
+// @Description Removes a co-author or a pending invitation from a post.
+// @Description The primary author removes anyone, co-authors only themselves
+// @Tags posts
+// @ID remove-co-author
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param user_id path int true "User ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/authors/{user_id} [delete]
+func RemoveCoAuthor(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		userID := convertToInt(c.Param("user_id"))
+		if id < 1 || userID < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		post, err := db.GetPostByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if int64(user.ID) != userID && !checkIfUserIsAuthor(c, post.Author.String) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if err := db.RemoveCoAuthor(c, pool, post.ID, int(userID)); err == sql.ErrNoRows {
+			HandleError(c, http.StatusBadRequest, "Author not found.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to remove author in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// ReorderCoAuthors godoc
+// @Summary Reorder the co-authors of a post
+// @Description Sets the byline order of the co-authors of a post, pending invitations included.
+// @Description The primary author always comes first
+// @Tags posts
+// @ID reorder-co-authors
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param order body api.CoAuthorOrderForm true "User IDs in order"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/authors [put]
+func ReorderCoAuthors(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody CoAuthorOrderForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		post, ok := findOwnPost(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.ReorderCoAuthors(c, pool, post.ID, reqBody.UserIDs); err == db.ErrInvalidOrder {
+			HandleError(c, http.StatusBadRequest, "Invalid order.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to reorder authors in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// GetInvitations godoc
+// @Summary Get co-author invitations
+// @Description Retrieve the posts the current user is invited to co-author
+// @Tags users
+// @ID get-invitations
+// @Accept  json
+// @Produce  json
+// @Param id path string true "me"
+// @Success 200 {object} api.SwaggerPosts
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/invitations [get]
+func GetInvitations(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param("id") != "me" {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if posts, err := db.GetInvitations(c, pool, user.ID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePosts(*posts))
+		}
+	}
+}
+
+// findOwnPost returns the post in the path when the current user is its primary author,
+// handling the error otherwise
+func findOwnPost(c *gin.Context, pool *sql.DB) (*models.Post, bool) {
+	id := convertToInt(c.Param("id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	post, err := db.GetPostByID(c, pool, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Post not found.")
+		return nil, false
+	}
+	if !checkIfUserIsAuthor(c, post.Author.String) {
+		HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+		return nil, false
+	}
+	return post, true
+}
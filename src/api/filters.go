@@ -0,0 +1,148 @@
+package api
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+type filterParser func(f *db.PostFilter, values []string) error
+
+// postFilterParsers parses the query parameters accepted by GET /posts
+var postFilterParsers = map[string]filterParser{
+	"tags": func(f *db.PostFilter, values []string) error {
+		f.AllTags = parseTagFilter(values)
+		return nil
+	},
+	"tags_any": func(f *db.PostFilter, values []string) error {
+		f.AnyTags = parseTagFilter(values)
+		return nil
+	},
+	"exclude_tags": func(f *db.PostFilter, values []string) error {
+		f.ExcludedTags = parseTagFilter(values)
+		return nil
+	},
+	"author": func(f *db.PostFilter, values []string) error {
+		for _, author := range splitFilterValues(values) {
+			f.Authors = append(f.Authors, strings.ToLower(author))
+		}
+		return nil
+	},
+	"published_after": func(f *db.PostFilter, values []string) (err error) {
+		f.PublishedAfter, err = parseFilterTime(values)
+		return err
+	},
+	"published_before": func(f *db.PostFilter, values []string) (err error) {
+		f.PublishedBefore, err = parseFilterTime(values)
+		return err
+	},
+	"created_after": func(f *db.PostFilter, values []string) (err error) {
+		f.CreatedAfter, err = parseFilterTime(values)
+		return err
+	},
+	"created_before": func(f *db.PostFilter, values []string) (err error) {
+		f.CreatedBefore, err = parseFilterTime(values)
+		return err
+	},
+	"min_likes": func(f *db.PostFilter, values []string) error {
+		if len(values) > 1 {
+			return errors.New("Must be given once.")
+		}
+		likes, err := strconv.Atoi(values[0])
+		if err != nil || likes < 0 {
+			return errors.New("Must be a non-negative integer.")
+		}
+		f.MinLikes = likes
+		return nil
+	},
+	"status": func(f *db.PostFilter, values []string) error {
+		if len(values) > 1 {
+			return errors.New("Must be given once.")
+		}
+		switch values[0] {
+		case db.StatusPublished, db.StatusScheduled, db.StatusDraft:
+			f.Status = values[0]
+			return nil
+		}
+		return errors.New("Must be one of published, scheduled or draft.")
+	},
+}
+
+// parsePostFilter reads the filters of GET /posts from the query,
+// along with an error message for every unknown or malformed filter
+func parsePostFilter(c *gin.Context) (*db.PostFilter, map[string]string) {
+	filter := &db.PostFilter{Status: db.StatusPublished}
+	fieldErrors := make(map[string]string)
+	for key, values := range c.Request.URL.Query() {
+		parse, known := postFilterParsers[key]
+		if !known {
+			fieldErrors[key] = "Unknown filter."
+			continue
+		}
+		if err := parse(filter, values); err != nil {
+			fieldErrors[key] = err.Error()
+		}
+	}
+
+	if isInvalidRange(filter.PublishedAfter, filter.PublishedBefore) {
+		fieldErrors["published_before"] = "Must be after published_after."
+	}
+	if isInvalidRange(filter.CreatedAfter, filter.CreatedBefore) {
+		fieldErrors["created_before"] = "Must be after created_after."
+	}
+
+	// Unpublished posts are only listed for their authors
+	if filter.Status != db.StatusPublished {
+		if username, exists := c.Get("username"); exists {
+			filter.Owner = username.(string)
+		} else {
+			fieldErrors["status"] = "Login required to list unpublished posts."
+		}
+	}
+	return filter, fieldErrors
+}
+
+// splitFilterValues splits comma separated values of a possibly repeated filter
+func splitFilterValues(values []string) []string {
+	var split []string
+	for _, value := range values {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				split = append(split, v)
+			}
+		}
+	}
+	return split
+}
+
+func parseTagFilter(values []string) []string {
+	var slugs []string
+	for _, tag := range splitFilterValues(values) {
+		slugs = append(slugs, db.Slugify(tag))
+	}
+	return slugs
+}
+
+// parseFilterTime parses an RFC3339 time or a date, which stands for its midnight in UTC
+func parseFilterTime(values []string) (time.Time, error) {
+	if len(values) > 1 {
+		return time.Time{}, errors.New("Must be given once.")
+	}
+
+	if t, err := time.Parse(time.RFC3339, values[0]); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", values[0]); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("Must be an RFC3339 time or a date (YYYY-MM-DD).")
+}
+
+func isInvalidRange(after time.Time, before time.Time) bool {
+	return !after.IsZero() && !before.IsZero() && !before.After(after)
+}
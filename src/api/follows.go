@@ -0,0 +1,86 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// FollowUser godoc
+// @Summary Follow a user
+// @Description Adds a user to the users the current user follows. The followed user is notified,
+// @Description and the current user is notified when they publish a post
+// @Tags users
+// @ID follow-user
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerFollow
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/follow [post]
+func FollowUser(pool *sql.DB) gin.HandlerFunc {
+	return changeFollow(pool, true)
+}
+
+// UnfollowUser godoc
+// @Summary Unfollow a user
+// @Description Removes a user from the users the current user follows
+// @Tags users
+// @ID unfollow-user
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerFollow
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/follow [delete]
+func UnfollowUser(pool *sql.DB) gin.HandlerFunc {
+	return changeFollow(pool, false)
+}
+
+// changeFollow returns a handler making the current user follow or unfollow a user
+func changeFollow(pool *sql.DB, follow bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+		followee, err := db.GetUserByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+		if user.ID == followee.ID {
+			HandleError(c, http.StatusBadRequest, "Users cannot follow themselves.")
+			return
+		}
+
+		if follow {
+			err = db.FollowUser(c, pool, user, followee.ID)
+		} else {
+			err = db.UnfollowUser(c, pool, user.ID, followee.ID)
+		}
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update follows in DB.")
+			return
+		}
+
+		followers, err := db.CountFollowers(c, pool, followee.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve follows from DB.")
+			return
+		}
+		c.JSON(http.StatusOK, response{"user_id": followee.ID, "following": follow, "followers": followers})
+	}
+}
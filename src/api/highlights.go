@@ -0,0 +1,242 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/anchor"
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// topPassages is the number of most highlighted passages returned
+const topPassages = 5
+
+// GetHighlights godoc
+// @Summary Get highlights of a post
+// @Description Retrieve the passages of a post highlighted by the most readers.
+// @Description Signed in users also get their own highlights with their private notes.
+// @Description Readers who cannot read a members-only post only get the passages in its preview, with paywall set
+// @Tags highlights
+// @ID get-highlights
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {object} api.SwaggerHighlights
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/{id}/highlights [get]
+func GetHighlights(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		post, ok := findHighlightedPost(c, pool)
+		if !ok {
+			return
+		}
+		entitled, ok := checkIfUserCanReadInFull(c, pool, post, env)
+		if !ok {
+			return
+		}
+		if !entitled {
+			post = previewPost(post)
+		}
+
+		passages, err := db.GetTopPassages(c, pool, post, topPassages)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve highlights from DB.")
+			return
+		}
+
+		top := make([]response, len(passages))
+		for i, p := range passages {
+			top[i] = serializePassage(p)
+		}
+		serialized := response{"top": top, "paywall": !entitled}
+
+		if user, err := getCurrentUser(c, pool); err == nil {
+			highlights, err := db.GetUserHighlights(c, pool, post, user.ID)
+			if err != nil {
+				HandleError(c, http.StatusInternalServerError, "Failed to retrieve highlights from DB.")
+				return
+			}
+
+			mine := make([]response, len(highlights))
+			for i, h := range highlights {
+				mine[i] = serializeHighlight(h)
+			}
+			serialized["mine"] = mine
+		}
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// CreateHighlight godoc
+// @Summary Highlight a passage
+// @Description Highlights a passage of a post, with an optional private note.
+// @Description Offsets are characters of the plain text of the post; the quote must match the passage.
+// @Description Only readers who can read a members-only post in full can highlight it
+// @Tags highlights
+// @ID create-highlight
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param highlight body api.HighlightForm true "Add highlight"
+// @Success 200 {object} api.SwaggerHighlight
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 402 {object} api.APIError "Payment Required"
+// @Router /posts/{id}/highlights [post]
+func CreateHighlight(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody HighlightForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if utf8.RuneCountInString(reqBody.Quote) > anchor.MaxQuoteLength {
+			HandleError(c, http.StatusBadRequest, fmt.Sprintf("Quote must be at most %d characters.", anchor.MaxQuoteLength))
+			return
+		}
+		if err := validateStruct(&reqBody); err != nil || strings.TrimSpace(reqBody.Quote) == "" {
+			HandleError(c, http.StatusBadRequest, "Quote required.")
+			return
+		}
+
+		post, ok := findHighlightedPost(c, pool)
+		if !ok {
+			return
+		}
+		entitled, ok := checkIfUserCanReadInFull(c, pool, post, env)
+		if !ok {
+			return
+		}
+		if !entitled {
+			HandleError(c, http.StatusPaymentRequired, "Subscription required.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		r := anchor.Range{Start: reqBody.Start, End: reqBody.End}
+		highlight, err := db.CreateHighlight(c, pool, post, user.ID, r, reqBody.Quote, optionalText(reqBody.Note))
+		if err == db.ErrQuoteNotFound {
+			HandleError(c, http.StatusBadRequest, "Quote not found in the post.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create highlight in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeHighlight(highlight))
+		}
+	}
+}
+
+// UpdateHighlight godoc
+// @Summary Update the note of a highlight
+// @Description Replaces the private note of a highlight of the current user. An empty note removes it
+// @Tags highlights
+// @ID update-highlight
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param highlight_id path int true "Highlight ID"
+// @Param note body api.HighlightNoteForm true "Note"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/highlights/{highlight_id} [put]
+func UpdateHighlight(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody HighlightNoteForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		highlight, ok := findOwnHighlight(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.UpdateHighlightNote(c, pool, highlight, optionalText(reqBody.Note)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update highlight in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// DeleteHighlight godoc
+// @Summary Delete a highlight
+// @Description Deletes a highlight of the current user
+// @Tags highlights
+// @ID delete-highlight
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param highlight_id path int true "Highlight ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/highlights/{highlight_id} [delete]
+func DeleteHighlight(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		highlight, ok := findOwnHighlight(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.DeleteHighlight(c, pool, highlight); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to delete highlight in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// findHighlightedPost returns the post in the path, handling the error when there is none
+func findHighlightedPost(c *gin.Context, pool *sql.DB) (*models.Post, bool) {
+	id := convertToInt(c.Param("id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	post, err := findVisiblePost(c, pool, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Post not found.")
+		return nil, false
+	}
+	return post, true
+}
+
+// findOwnHighlight returns the highlight in the path when it belongs to the current user,
+// handling the error otherwise
+func findOwnHighlight(c *gin.Context, pool *sql.DB) (*models.Highlight, bool) {
+	postID := convertToInt(c.Param("id"))
+	id := convertToInt(c.Param("highlight_id"))
+	if postID < 1 || id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	user, err := getCurrentUser(c, pool)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "User not found.")
+		return nil, false
+	}
+
+	highlight, err := db.GetUserHighlight(c, pool, int(postID), user.ID, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Highlight not found.")
+		return nil, false
+	}
+	return highlight, true
+}
@@ -0,0 +1,387 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// GetUserLists godoc
+// @Summary Get reading lists of a user
+// @Description Retrieve the public reading lists of a user. Users also see their own private lists
+// @Tags users
+// @ID get-user-lists
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerReadingLists
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /users/{id}/lists [get]
+func GetUserLists(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		user, err := db.GetUserByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		owner := false
+		if current, err := getCurrentUser(c, pool); err == nil && current.ID == user.ID {
+			owner = true
+			if _, err := db.GetDefaultList(c, pool, user.ID); err != nil {
+				HandleError(c, http.StatusInternalServerError, "Failed to retrieve lists from DB.")
+				return
+			}
+		}
+
+		lists, err := db.GetUserLists(c, pool, user.ID, owner)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve lists from DB.")
+			return
+		}
+
+		serialized := make([]response, len(lists))
+		for i, l := range lists {
+			serialized[i] = serializeReadingList(&l.ReadingList, l.PostCount)
+		}
+		c.JSON(http.StatusOK, response{"lists": serialized})
+	}
+}
+
+// CreateList godoc
+// @Summary Create a reading list
+// @Description Creates a named reading list for the current user
+// @Tags lists
+// @ID create-list
+// @Accept  json
+// @Produce  json
+// @Param list body api.ReadingListForm true "Add list"
+// @Success 200 {object} api.SwaggerReadingList
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /lists [post]
+func CreateList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ReadingListForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		reqBody.Name = strings.TrimSpace(reqBody.Name)
+		if err := validateStruct(&reqBody); err != nil || reqBody.Name == "" {
+			HandleError(c, http.StatusBadRequest, "Name required.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if list, err := db.CreateList(c, pool, user.ID, reqBody.Name, reqBody.Public); err == db.ErrListExists {
+			HandleError(c, http.StatusBadRequest, "List already exists.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create list in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeReadingList(list, 0))
+		}
+	}
+}
+
+// GetList godoc
+// @Summary Get a reading list
+// @Description Retrieve a reading list with its posts in order. Private lists are only visible to their owner
+// @Tags lists
+// @ID get-list
+// @Accept  json
+// @Produce  json
+// @Param id path int true "List ID"
+// @Success 200 {object} api.SwaggerReadingListPage
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /lists/{id} [get]
+func GetList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		list, ok := findList(c, pool)
+		if !ok {
+			return
+		}
+		if !list.IsPublic && !checkIfUserOwnsList(c, pool, list) {
+			HandleError(c, http.StatusBadRequest, "List not found.")
+			return
+		}
+
+		posts, err := db.GetListPosts(c, pool, list.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+			return
+		}
+
+		serialized := serializeReadingList(list, int64(len(*posts)))
+		summaries := make([]response, len(*posts))
+		for i, p := range *posts {
+			summaries[i] = serializePostSummary(p)
+		}
+		serialized["posts"] = summaries
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// DeleteList godoc
+// @Summary Delete a reading list
+// @Description Deletes a reading list of the current user. The default list cannot be deleted
+// @Tags lists
+// @ID delete-list
+// @Accept  json
+// @Produce  json
+// @Param id path int true "List ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /lists/{id} [delete]
+func DeleteList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		list, ok := findOwnList(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.DeleteList(c, pool, list); err == db.ErrDefaultList {
+			HandleError(c, http.StatusBadRequest, "The default list cannot be deleted.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to delete list in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// AddPostToList godoc
+// @Summary Add a post to a reading list
+// @Description Appends a post to a reading list of the current user
+// @Tags lists
+// @ID add-list-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "List ID"
+// @Param post body api.ReadingListPostForm true "Post to add"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /lists/{id}/posts [post]
+func AddPostToList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ReadingListPostForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Post ID required.")
+			return
+		}
+
+		list, ok := findOwnList(c, pool)
+		if !ok {
+			return
+		}
+
+		if _, err := findVisiblePost(c, pool, int64(reqBody.PostID)); err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if err := db.AddPostToList(c, pool, list.ID, reqBody.PostID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to add post to list in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// RemovePostFromList godoc
+// @Summary Remove a post from a reading list
+// @Description Removes a post from a reading list of the current user
+// @Tags lists
+// @ID remove-list-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "List ID"
+// @Param post_id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /lists/{id}/posts/{post_id} [delete]
+func RemovePostFromList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := convertToInt(c.Param("post_id"))
+		if postID < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid post ID.")
+			return
+		}
+
+		list, ok := findOwnList(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.RemovePostFromList(c, pool, list.ID, int(postID)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to remove post from list in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// ReorderList godoc
+// @Summary Reorder a reading list
+// @Description Puts the posts of a reading list of the current user in the given order.
+// @Description The order must contain every post in the list once
+// @Tags lists
+// @ID reorder-list
+// @Accept  json
+// @Produce  json
+// @Param id path int true "List ID"
+// @Param order body api.ReadingListOrderForm true "Post IDs in order"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /lists/{id}/posts [put]
+func ReorderList(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ReadingListOrderForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		list, ok := findOwnList(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.ReorderList(c, pool, list.ID, reqBody.PostIDs); err == db.ErrInvalidOrder {
+			HandleError(c, http.StatusBadRequest, "Invalid order.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to reorder list in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// BookmarkPost godoc
+// @Summary Bookmark a post
+// @Description Saves a post to the default reading list of the current user
+// @Tags posts
+// @ID bookmark-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/bookmark [post]
+func BookmarkPost(pool *sql.DB) gin.HandlerFunc {
+	return changeBookmark(pool, db.AddPostToList)
+}
+
+// UnbookmarkPost godoc
+// @Summary Remove a bookmark
+// @Description Removes a post from the default reading list of the current user
+// @Tags posts
+// @ID unbookmark-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/bookmark [delete]
+func UnbookmarkPost(pool *sql.DB) gin.HandlerFunc {
+	return changeBookmark(pool, db.RemovePostFromList)
+}
+
+// changeBookmark returns a handler changing a post in the default list of the current user
+func changeBookmark(pool *sql.DB, change func(ctx context.Context, db *sql.DB, listID int, postID int) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		list, err := db.GetDefaultList(c, pool, user.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve list from DB.")
+			return
+		}
+
+		if err := change(c, pool, list.ID, post.ID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update bookmark in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// findList returns the list in the path, handling the error when there is none
+func findList(c *gin.Context, pool *sql.DB) (*models.ReadingList, bool) {
+	id := convertToInt(c.Param("id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	list, err := db.GetListByID(c, pool, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "List not found.")
+		return nil, false
+	}
+	return list, true
+}
+
+// findOwnList returns the list in the path when it belongs to the current user,
+// handling the error otherwise
+func findOwnList(c *gin.Context, pool *sql.DB) (*models.ReadingList, bool) {
+	list, ok := findList(c, pool)
+	if !ok {
+		return nil, false
+	}
+	if !checkIfUserOwnsList(c, pool, list) {
+		HandleError(c, http.StatusBadRequest, "User is not the owner of the list.")
+		return nil, false
+	}
+	return list, true
+}
+
+func checkIfUserOwnsList(c *gin.Context, pool *sql.DB, list *models.ReadingList) bool {
+	user, err := getCurrentUser(c, pool)
+	return err == nil && user.ID == list.UserID
+}
@@ -0,0 +1,278 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// notificationActions describe what the actors of each type of notification did
+var notificationActions = map[string]string{
+	db.NotificationFollow:    "followed you",
+	db.NotificationLike:      "liked your post",
+	db.NotificationComment:   "commented on your post",
+	db.NotificationReply:     "replied to your comment",
+	db.NotificationMention:   "mentioned you in a post",
+	db.NotificationPublished: "published a new post",
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Retrieve the notifications of the current user, newest first, with the number of unread ones.
+// @Description Events of the same kind on the same post are aggregated into one notification while it is unread.
+// @Description Pass next_cursor back as cursor to get the following page; it is empty on the last page
+// @Tags notifications
+// @ID get-notifications
+// @Accept  json
+// @Produce  json
+// @Param cursor query string false "Cursor returned with the previous page"
+// @Param per_page query int false "Notifications per page, at most 100"
+// @Success 200 {object} api.SwaggerNotifications
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /notifications [get]
+func GetNotifications(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		perPage, err := strconv.Atoi(c.DefaultQuery("per_page", strconv.Itoa(defaultPerPage)))
+		if err != nil || perPage < 1 || perPage > maxPerPage {
+			HandleError(c, http.StatusBadRequest, "Invalid page size.")
+			return
+		}
+		cursor, err := decodeNotificationCursor(c.Query("cursor"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid cursor.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		// One more than a page tells whether there is a next one
+		notifications, err := db.GetNotifications(c, pool, user.ID, cursor, perPage+1)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve notifications from DB.")
+			return
+		}
+		unread, err := db.CountUnreadNotifications(c, pool, user.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve notifications from DB.")
+			return
+		}
+
+		next := ""
+		if len(notifications) > perPage {
+			notifications = notifications[:perPage]
+			next = encodeNotificationCursor(notifications[perPage-1])
+		}
+		serialized := make([]response, len(notifications))
+		for i, n := range notifications {
+			serialized[i] = serializeNotification(n)
+		}
+		c.JSON(http.StatusOK, response{
+			"unread_count":  unread,
+			"next_cursor":   next,
+			"notifications": serialized,
+		})
+	}
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Marks a notification of the current user as read. Events after that start a new notification
+// @Tags notifications
+// @ID mark-notification-read
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} api.SwaggerUnreadCount
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /notifications/{id}/read [post]
+func MarkNotificationRead(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.MarkNotificationRead(c, pool, user.ID, int(id), time.Now()); err == sql.ErrNoRows {
+			HandleError(c, http.StatusBadRequest, "Notification not found.")
+			return
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update notification in DB.")
+			return
+		}
+		respondUnreadCount(c, pool, user)
+	}
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description Marks every notification of the current user as read
+// @Tags notifications
+// @ID mark-all-notifications-read
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerUnreadCount
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /notifications/all/read [post]
+func MarkAllNotificationsRead(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if _, err := db.MarkAllNotificationsRead(c, pool, user.ID, time.Now()); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update notifications in DB.")
+			return
+		}
+		respondUnreadCount(c, pool, user)
+	}
+}
+
+// GetNotificationPreferences godoc
+// @Summary Get notification preferences
+// @Description Retrieve whether each type of notification is on for the current user
+// @Tags notifications
+// @ID get-notification-preferences
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerNotificationPreferences
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /notifications/preferences [get]
+func GetNotificationPreferences(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+		respondNotificationPreferences(c, pool, user)
+	}
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update notification preferences
+// @Description Turns types of notifications on or off for the current user. Types left out keep their setting
+// @Tags notifications
+// @ID update-notification-preferences
+// @Accept  json
+// @Produce  json
+// @Param preferences body api.SwaggerNotificationPreferences true "Types to turn on or off"
+// @Success 200 {object} api.SwaggerNotificationPreferences
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /notifications/preferences [put]
+func UpdateNotificationPreferences(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var prefs map[string]bool
+		if err := c.ShouldBindJSON(&prefs); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid preferences.")
+			return
+		}
+		for t := range prefs {
+			if _, ok := notificationActions[t]; !ok {
+				HandleError(c, http.StatusBadRequest, "Invalid notification type.")
+				return
+			}
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.SetNotificationPreferences(c, pool, user.ID, prefs); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update preferences in DB.")
+			return
+		}
+		respondNotificationPreferences(c, pool, user)
+	}
+}
+
+func respondUnreadCount(c *gin.Context, pool *sql.DB, user *models.User) {
+	unread, err := db.CountUnreadNotifications(c, pool, user.ID)
+	if err != nil {
+		HandleError(c, http.StatusInternalServerError, "Failed to retrieve notifications from DB.")
+		return
+	}
+	c.JSON(http.StatusOK, response{"unread_count": unread})
+}
+
+func respondNotificationPreferences(c *gin.Context, pool *sql.DB, user *models.User) {
+	prefs, err := db.GetNotificationPreferences(c, pool, user.ID)
+	if err != nil {
+		HandleError(c, http.StatusInternalServerError, "Failed to retrieve preferences from DB.")
+		return
+	}
+	c.JSON(http.StatusOK, prefs)
+}
+
+// notificationMessage describes a notification, aggregating its actors
+// as in "Alice and 12 others liked your post"
+func notificationMessage(n *models.Notification) string {
+	actor := strings.Title(strings.ToLower(n.ActorName))
+	switch others := n.ActorCount - 1; {
+	case others == 1:
+		actor += " and 1 other"
+	case others > 1:
+		actor += fmt.Sprintf(" and %d others", others)
+	}
+	return actor + " " + notificationActions[n.Type]
+}
+
+// encodeNotificationCursor returns the cursor of the page after a notification
+func encodeNotificationCursor(n *models.Notification) string {
+	raw := fmt.Sprintf("%d:%d", n.CreatedAt.UnixNano(), n.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeNotificationCursor parses a cursor made by encodeNotificationCursor.
+// An empty cursor yields nil, meaning the first page
+func decodeNotificationCursor(s string) (*db.NotificationCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 {
+		return nil, errors.New("Invalid cursor.")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	return &db.NotificationCursor{CreatedAt: time.Unix(0, nanos), ID: id}, nil
+}
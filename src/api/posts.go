@@ -4,60 +4,74 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
-	"net/url"
-	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
 	"github.com/json9512/mediumclone-backendwithgo/src/db"
-	"github.com/json9512/mediumclone-backendwithgo/src/models"
 )
 
-type queryChecker struct {
-	tagsExist    bool
-	authorExists bool
-}
-
 // GetPosts godoc
 // @Summary Get posts
 // @Tags posts
-// @Description Retrieve posts from db
+// @Description Retrieve posts from db, newest first. Lists are comma separated and list filters may be repeated.
+// @Description Times are RFC3339 or dates; after bounds are inclusive, before bounds exclusive
 // @ID get-posts
 // @Accept  json
 // @Produce  json
-// @Param tags query string false "tags"
-// @Param author query string false "author"
+// @Param tags query string false "Posts with all of the tags"
+// @Param tags_any query string false "Posts with any of the tags"
+// @Param exclude_tags query string false "Posts with none of the tags"
+// @Param author query string false "Posts by any of the authors"
+// @Param published_after query string false "Published at or after"
+// @Param published_before query string false "Published before"
+// @Param created_after query string false "Created at or after"
+// @Param created_before query string false "Created before"
+// @Param min_likes query int false "Minimum number of likes"
+// @Param status query string false "published (default), or scheduled or draft for your own posts"
 // @Success 200 {object} api.SwaggerPosts
-// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 400 {object} api.APIFieldError "Bad Request"
 // @Router /posts [get]
 func GetPosts(pool *sql.DB) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		queryChecker := &queryChecker{false, false}
-		queries := c.Request.URL.Query()
-		res := handleQuery(c, pool, queries, queryChecker)
-		if !res {
-			posts, err := db.GetPosts(c, pool)
-			if err != nil {
-				HandleError(c, http.StatusBadRequest, "No posts in db.")
-			} else {
-				c.JSON(200, serializePosts(*posts))
-			}
+		filter, fieldErrors := parsePostFilter(c)
+		if len(fieldErrors) > 0 {
+			HandleFieldErrors(c, http.StatusBadRequest, "Invalid filters.", fieldErrors)
+			return
 		}
+
+		posts, err := db.GetPostsByFilter(c, pool, filter)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+			return
+		}
+
+		serialized := serializePosts(*posts)
+		if err := markBookmarks(c, pool, serialized["posts"].([]response)...); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve bookmarks from DB.")
+			return
+		}
+		c.JSON(http.StatusOK, serialized)
 	}
 }
 
 // GetPost godoc
 // @Summary Get post
-// @Description Retrieve a post by its ID
+// @Description Retrieve a post by its ID. For signed in users, bookmarked tells whether they saved the post.
+// @Description Posts in a series link to the previous and next parts.
+// @Description Readers without a subscription read a few members-only posts a month, after which
+// @Description they get a preview of the post with paywall set
 // @Tags posts
 // @ID get-post
 // @Accept  json
 // @Produce  json
 // @Param id path string true "Post ID"
+// @Param format query string false "Document format: markdown (default), html, both or blocks"
 // @Success 200 {string} string	"ok"
 // @Failure 400 {object} api.APIError "Bad Request"
 // @Router /posts/{id} [get]
-func GetPost(pool *sql.DB) gin.HandlerFunc {
+func GetPost(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		idStr := c.Param("id")
 		id := convertToInt(idStr)
@@ -66,11 +80,49 @@ func GetPost(pool *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		if post, err := db.GetPostByID(c, pool, id); err != nil {
+		format := c.DefaultQuery("format", formatMarkdown)
+		if !checkIfFormatIsValid(format) {
+			HandleError(c, http.StatusBadRequest, "Invalid format.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
 			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		entitled, meter, err := checkIfUserCanRead(c, pool, post, env.FreeArticlesPerMonth)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve subscription from DB.")
+			return
+		}
+
+		var serialized response
+		if entitled {
+			serialized = serializePostInFormat(post, format)
 		} else {
-			c.JSON(http.StatusOK, serializePost(post))
+			serialized = serializePostInFormat(previewPost(post), format)
 		}
+		serialized["paywall"] = !entitled
+		if meter != nil {
+			serialized["metered"] = response{"allowance": env.FreeArticlesPerMonth, "remaining": meter.Remaining}
+		}
+
+		if part, err := db.GetSeriesPart(c, pool, post); err == nil {
+			serialized["series"] = serializeSeriesPart(part)
+		} else if err != sql.ErrNoRows {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve series from DB.")
+			return
+		}
+		if err := markBookmarks(c, pool, serialized); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve bookmarks from DB.")
+			return
+		}
+
+		// A view that fails to be counted should not fail the request
+		_ = db.RecordPostView(c, pool, post.ID, time.Now())
+		c.JSON(http.StatusOK, serialized)
 	}
 }
 
@@ -94,8 +146,65 @@ func GetLikesForPost(pool *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		if likes, err := db.GetLikesForPost(c, pool, id); err != nil {
+		if post, err := findVisiblePost(c, pool, id); err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+		} else {
+			c.JSON(http.StatusOK, &response{"likes": post.Likes.Int})
+		}
+	}
+}
+
+// LikePost godoc
+// @Summary Like a post
+// @Description Adds the like of the current user to a post. Liking a post twice has no effect
+// @Tags posts
+// @ID like-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/like [post]
+func LikePost(pool *sql.DB) gin.HandlerFunc {
+	return changeLike(pool, db.LikePost)
+}
+
+// UnlikePost godoc
+// @Summary Unlike a post
+// @Description Removes the like of the current user from a post
+// @Tags posts
+// @ID unlike-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/like [delete]
+func UnlikePost(pool *sql.DB) gin.HandlerFunc {
+	return changeLike(pool, db.UnlikePost)
+}
+
+// changeLike returns a handler changing the like of the current user on a post
+func changeLike(pool *sql.DB, change func(ctx context.Context, db *sql.DB, userID int, postID int64) (int, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if likes, err := change(c, pool, user.ID, id); err == sql.ErrNoRows {
 			HandleError(c, http.StatusBadRequest, "Post not found.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update likes in DB.")
 		} else {
 			c.JSON(http.StatusOK, &response{"likes": likes})
 		}
@@ -122,18 +231,36 @@ func CreatePost(pool *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		if err := validateStruct(&reqBody); err != nil || reqBody.Doc == "" {
+		if err := validateStruct(&reqBody); err != nil || (reqBody.Doc == "" && len(reqBody.Blocks) == 0) {
 			HandleError(c, http.StatusBadRequest, "ID, Doc required.")
 			return
 		}
 
+		postBlocks, err := parseBlocks(reqBody.Blocks)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid blocks: "+err.Error())
+			return
+		}
+
 		username, exists := c.Get("username")
 		if !exists {
 			HandleError(c, http.StatusBadRequest, "Username not found.")
 			return
 		}
 
-		post := bindFormToPost(&reqBody, username.(string))
+		publishAt, err := parsePublishAt(reqBody.PublishAt)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid publish time.")
+			return
+		}
+
+		post, err := bindFormToPost(&reqBody, username.(string))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Too many tags.")
+			return
+		}
+		post.PublishAt = publishAt
+		post.Blocks = postBlocks
 		if createdPost, err := db.InsertPost(c, pool, post); err != nil {
 			HandleError(c, http.StatusInternalServerError, "Failed to create post in DB.")
 		} else {
@@ -144,7 +271,7 @@ func CreatePost(pool *sql.DB) gin.HandlerFunc {
 
 // UpdatePost godoc
 // @Summary Update a post
-// @Description Updates a post by the provided data
+// @Description Updates a post by the provided data. Its co-authors and the editors of its publication may update it too
 // @Tags posts
 // @ID update-post
 // @Accept  json
@@ -173,18 +300,27 @@ func UpdatePost(pool *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		if !checkIfUserIsAuthor(c, queriedPost.Author.String) {
+		if !checkIfUserCanEditPost(c, pool, queriedPost) {
 			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
 			return
 		}
 
 		post, err := bindUpdateFormToPost(&reqBody, queriedPost.Author.String)
-		if err != nil {
+		if err == db.ErrTooManyTags {
+			HandleError(c, http.StatusBadRequest, "Too many tags.")
+			return
+		} else if err != nil {
 			HandleError(c, http.StatusBadRequest, "Update form not valid.")
 			return
 		}
 
-		if createdPost, err := db.UpdatePost(c, pool, postID, post); err != nil {
+		if post.Blocks, err = parseBlocks(reqBody.Blocks); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid blocks: "+err.Error())
+			return
+		}
+
+		username, _ := c.Get("username")
+		if createdPost, err := db.UpdatePost(c, pool, postID, post, username.(string)); err != nil {
 			HandleError(c, http.StatusInternalServerError, "Failed to update post in DB.")
 		} else {
 			c.JSON(http.StatusOK, serializePost(createdPost))
@@ -194,7 +330,7 @@ func UpdatePost(pool *sql.DB) gin.HandlerFunc {
 
 // DeletePost godoc
 // @Summary Delete a post
-// @Description Deletes a post by its ID
+// @Description Moves a post to the trash by its ID. Only the primary author deletes a post
 // @Tags posts
 // @ID delete-post
 // @Accept  json
@@ -231,62 +367,3 @@ func DeletePost(pool *sql.DB) gin.HandlerFunc {
 		}
 	}
 }
-
-func checkIfTagsExist(q url.Values) (*[]string, bool) {
-	tags, tagsExist := q["tags"]
-	if !tagsExist {
-		return nil, false
-	}
-
-	if tagsExist && strings.Contains(tags[0], ",") {
-		tags = strings.Split(tags[0], ",")
-		return &tags, true
-	} else {
-		return &tags, true
-	}
-}
-
-func checkIfAuthorExists(q url.Values) (*string, bool) {
-	rawAuthor, exists := q["author"]
-	if !exists {
-		return nil, false
-	}
-
-	if len(rawAuthor) > 0 {
-		author := strings.ToLower(rawAuthor[0])
-		return &author, true
-	} else {
-		author := strings.ToLower(rawAuthor[0])
-		return &author, true
-	}
-}
-
-func executeQuery(c context.Context, pool *sql.DB, tags *[]string, author *string, checker *queryChecker) (*models.PostSlice, error) {
-	if checker.authorExists && checker.tagsExist {
-		return db.GetPostsByTagsAndFilterByAuthor(c, pool, *tags, *author)
-	} else if checker.authorExists {
-		return db.GetPostsByAuthor(c, pool, *author)
-	} else if checker.tagsExist {
-		return db.GetPostsByTags(c, pool, *tags)
-	}
-	return nil, nil
-}
-
-func handleQuery(c *gin.Context, pool *sql.DB, q url.Values, checker *queryChecker) bool {
-	success := false
-	if checkIfQueriesExist(q) {
-		tags, tagsExist := checkIfTagsExist(q)
-		author, authorExists := checkIfAuthorExists(q)
-		checker.authorExists = authorExists
-		checker.tagsExist = tagsExist
-		posts, err := executeQuery(c, pool, tags, author, checker)
-
-		if err != nil || posts == nil {
-			HandleError(c, http.StatusBadRequest, "No posts in db.")
-		} else {
-			c.JSON(200, serializePosts(*posts))
-			success = true
-		}
-	}
-	return success
-}
@@ -0,0 +1,426 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// CreatePublication godoc
+// @Summary Create a publication
+// @Description Creates a publication owned by the current user. Its slug is derived from its name
+// @Tags publications
+// @ID create-publication
+// @Accept  json
+// @Produce  json
+// @Param publication body api.PublicationForm true "Add publication"
+// @Success 200 {object} api.SwaggerPublication
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications [post]
+func CreatePublication(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody PublicationForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		reqBody.Name = strings.TrimSpace(reqBody.Name)
+		if err := validateStruct(&reqBody); err != nil || db.Slugify(reqBody.Name) == "" {
+			HandleError(c, http.StatusBadRequest, "Name required.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		publication, err := db.CreatePublication(c, pool, user.ID, reqBody.Name, optionalText(reqBody.Description))
+		if err == db.ErrPublicationExists {
+			HandleError(c, http.StatusBadRequest, "Publication already exists.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create publication in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePublication(publication))
+		}
+	}
+}
+
+// GetPublication godoc
+// @Summary Get a publication
+// @Description Retrieve a publication with its members and a page of its published posts
+// @Tags publications
+// @ID get-publication
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param page query int false "Page number, starting at 1"
+// @Param per_page query int false "Posts per page, at most 100"
+// @Success 200 {object} api.SwaggerPublication
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /publications/{slug} [get]
+func GetPublication(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, perPage, err := parsePagination(c)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid page.")
+			return
+		}
+
+		publication, ok := findPublication(c, pool)
+		if !ok {
+			return
+		}
+
+		members, err := db.GetPublicationMembers(c, pool, publication.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve publication from DB.")
+			return
+		}
+		posts, err := db.GetPublicationPosts(c, pool, publication.ID, perPage, (page-1)*perPage)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+			return
+		}
+
+		serializedMembers := make([]response, len(members))
+		for i, m := range members {
+			serializedMembers[i] = serializePublicationMember(m)
+		}
+		serializedPosts := make([]response, len(*posts))
+		for i, p := range *posts {
+			serializedPosts[i] = serializePostSummary(p)
+		}
+
+		serialized := serializePublication(publication)
+		serialized["members"] = serializedMembers
+		serialized["page"] = page
+		serialized["per_page"] = perPage
+		serialized["posts"] = serializedPosts
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// SetPublicationMember godoc
+// @Summary Add or change a member
+// @Description Adds a user to a publication or changes their role: owner, editor or writer. Only owners manage members
+// @Tags publications
+// @ID set-publication-member
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param member body api.PublicationMemberForm true "Member"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/members [put]
+func SetPublicationMember(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody PublicationMemberForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "User ID, Role required.")
+			return
+		}
+
+		if !checkIfRoleIsValid(reqBody.Role) {
+			HandleError(c, http.StatusBadRequest, "Invalid role.")
+			return
+		}
+
+		publication, _, ok := findPublicationAsMember(c, pool, "User is not an owner of the publication.", db.RoleOwner)
+		if !ok {
+			return
+		}
+
+		if _, err := db.GetUserByID(c, pool, int64(reqBody.UserID)); err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.SetPublicationMember(c, pool, publication.ID, reqBody.UserID, reqBody.Role); err == db.ErrLastOwner {
+			HandleError(c, http.StatusBadRequest, "A publication needs an owner.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update member in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// RemovePublicationMember godoc
+// @Summary Remove a member
+// @Description Removes a user from a publication. Owners remove anyone, other members only themselves
+// @Tags publications
+// @ID remove-publication-member
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param user_id path int true "User ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/members/{user_id} [delete]
+func RemovePublicationMember(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := convertToInt(c.Param("user_id"))
+		if userID < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid user ID.")
+			return
+		}
+
+		publication, role, ok := findPublicationAsMember(c, pool, "User is not a member of the publication.", db.RoleOwner, db.RoleEditor, db.RoleWriter)
+		if !ok {
+			return
+		}
+
+		user, _ := getCurrentUser(c, pool)
+		if role != db.RoleOwner && int64(user.ID) != userID {
+			HandleError(c, http.StatusBadRequest, "User is not an owner of the publication.")
+			return
+		}
+
+		if err := db.RemovePublicationMember(c, pool, publication.ID, int(userID)); err == db.ErrLastOwner {
+			HandleError(c, http.StatusBadRequest, "A publication needs an owner.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to remove member in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// SubmitPost godoc
+// @Summary Submit a post
+// @Description Submits an unpublished post of the current user to the review queue of a publication they are a member of
+// @Tags publications
+// @ID submit-post
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param submission body api.SubmissionForm true "Post to submit"
+// @Success 200 {object} api.SwaggerSubmission
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/submissions [post]
+func SubmitPost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody SubmissionForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Post ID required.")
+			return
+		}
+
+		publication, _, ok := findPublicationAsMember(c, pool, "User is not a member of the publication.", db.RoleOwner, db.RoleEditor, db.RoleWriter)
+		if !ok {
+			return
+		}
+
+		post, err := db.GetPostByID(c, pool, int64(reqBody.PostID))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if !checkIfUserIsAuthor(c, post.Author.String) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		user, _ := getCurrentUser(c, pool)
+		submission, err := db.SubmitPost(c, pool, publication.ID, post, user.ID)
+		if err == db.ErrNotDraft || err == db.ErrAlreadySubmitted {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to submit post in DB.")
+		} else {
+			serialized := serializeSubmission(submission)
+			serialized["post"] = serializePostSummary(post)
+			c.JSON(http.StatusOK, serialized)
+		}
+	}
+}
+
+// GetSubmissions godoc
+// @Summary Get submissions
+// @Description Retrieve the submissions to a publication in a state, oldest first.
+// @Description Editors and owners see every submission, writers their own
+// @Tags publications
+// @ID get-submissions
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param status query string false "pending (default), approved or rejected"
+// @Success 200 {object} api.SwaggerSubmissions
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/submissions [get]
+func GetSubmissions(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status := c.DefaultQuery("status", db.SubmissionPending)
+		if status != db.SubmissionPending && status != db.SubmissionApproved && status != db.SubmissionRejected {
+			HandleError(c, http.StatusBadRequest, "Invalid status.")
+			return
+		}
+
+		publication, role, ok := findPublicationAsMember(c, pool, "User is not a member of the publication.", db.RoleOwner, db.RoleEditor, db.RoleWriter)
+		if !ok {
+			return
+		}
+
+		submittedBy := 0
+		if role == db.RoleWriter {
+			user, _ := getCurrentUser(c, pool)
+			submittedBy = user.ID
+		}
+
+		submissions, err := db.GetSubmissions(c, pool, publication.ID, status, submittedBy)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve submissions from DB.")
+			return
+		}
+
+		serialized := make([]response, len(*submissions))
+		for i, s := range *submissions {
+			serialized[i] = serializeSubmission(s)
+		}
+		c.JSON(http.StatusOK, response{"submissions": serialized})
+	}
+}
+
+// ApproveSubmission godoc
+// @Summary Approve a submission
+// @Description Adds the submitted post to the publication and publishes it unless it is scheduled. Editors and owners review submissions
+// @Tags publications
+// @ID approve-submission
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param submission_id path int true "Submission ID"
+// @Param review body api.ReviewForm false "Note for the writer"
+// @Success 200 {object} api.SwaggerSubmission
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/submissions/{submission_id}/approve [post]
+func ApproveSubmission(pool *sql.DB) gin.HandlerFunc {
+	return reviewSubmission(pool, true)
+}
+
+// RejectSubmission godoc
+// @Summary Reject a submission
+// @Description Turns down a submission with a note for the writer. Editors and owners review submissions
+// @Tags publications
+// @ID reject-submission
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Publication slug"
+// @Param submission_id path int true "Submission ID"
+// @Param review body api.ReviewForm false "Note for the writer"
+// @Success 200 {object} api.SwaggerSubmission
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /publications/{slug}/submissions/{submission_id}/reject [post]
+func RejectSubmission(pool *sql.DB) gin.HandlerFunc {
+	return reviewSubmission(pool, false)
+}
+
+// reviewSubmission returns a handler approving or rejecting a submission
+func reviewSubmission(pool *sql.DB, approve bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ReviewForm
+		if c.Request.ContentLength > 0 {
+			if err := extractData(c, &reqBody); err != nil {
+				HandleError(c, http.StatusBadRequest, "Invalid request data.")
+				return
+			}
+		}
+
+		id := convertToInt(c.Param("submission_id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid submission ID.")
+			return
+		}
+
+		publication, _, ok := findPublicationAsMember(c, pool, "User is not an editor of the publication.", db.RoleOwner, db.RoleEditor)
+		if !ok {
+			return
+		}
+
+		submission, err := db.GetSubmission(c, pool, publication.ID, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Submission not found.")
+			return
+		}
+
+		user, _ := getCurrentUser(c, pool)
+		err = db.ReviewSubmission(c, pool, submission, user.ID, approve, optionalText(reqBody.Note), time.Now())
+		if err == db.ErrSubmissionReviewed {
+			HandleError(c, http.StatusBadRequest, "Submission was already reviewed.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to review submission in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeSubmission(submission))
+		}
+	}
+}
+
+// findPublication returns the publication in the path, handling the error when there is none
+func findPublication(c *gin.Context, pool *sql.DB) (*models.Publication, bool) {
+	publication, err := db.GetPublicationBySlug(c, pool, c.Param("slug"))
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Publication not found.")
+		return nil, false
+	}
+	return publication, true
+}
+
+// findPublicationAsMember returns the publication in the path with the role of the current user
+// when it is one of the given roles, responding with the denied message otherwise
+func findPublicationAsMember(c *gin.Context, pool *sql.DB, denied string, roles ...string) (*models.Publication, string, bool) {
+	publication, ok := findPublication(c, pool)
+	if !ok {
+		return nil, "", false
+	}
+
+	user, err := getCurrentUser(c, pool)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "User not found.")
+		return nil, "", false
+	}
+
+	role, err := db.GetMemberRole(c, pool, publication.ID, user.ID)
+	if err != nil {
+		HandleError(c, http.StatusInternalServerError, "Failed to retrieve publication from DB.")
+		return nil, "", false
+	}
+	for _, r := range roles {
+		if role == r {
+			return publication, role, true
+		}
+	}
+
+	HandleError(c, http.StatusBadRequest, denied)
+	return nil, "", false
+}
+
+func checkIfRoleIsValid(role string) bool {
+	return role == db.RoleOwner || role == db.RoleEditor || role == db.RoleWriter
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+const defaultRelatedPosts = 5
+
+// GetRelatedPosts godoc
+// @Summary Get related posts
+// @Description Published posts to read after a post, best first. Posts are related by shared tags,
+// @Description a shared author, readers who liked both and similar titles
+// @Tags posts
+// @ID get-related-posts
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param limit query int false "Number of posts, at most 20"
+// @Success 200 {object} api.SwaggerPosts
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/{id}/related [get]
+func GetRelatedPosts(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultRelatedPosts)))
+		if err != nil || limit < 1 || limit > db.MaxRelatedPosts {
+			HandleError(c, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if related, err := db.GetRelatedPosts(c, pool, post.ID, limit, time.Now()); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve related posts from DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePosts(*related))
+		}
+	}
+}
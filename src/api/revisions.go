@@ -0,0 +1,245 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/diff"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// GetRevisions godoc
+// @Summary Get revisions of a post
+// @Description Retrieve every revision of a post, oldest first.
+// @Description The revisions of unpublished posts are only shown to their editors
+// @Description Readers who cannot read a members-only post get the previews of its revisions with paywall set
+// @Tags revisions
+// @ID get-revisions
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/{id}/revisions [get]
+func GetRevisions(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+		entitled, ok := checkIfUserCanReadInFull(c, pool, post, env)
+		if !ok {
+			return
+		}
+
+		revisions, err := db.GetRevisions(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve revisions from DB.")
+			return
+		}
+		if !entitled {
+			for i, r := range *revisions {
+				(*revisions)[i] = previewRevision(r)
+			}
+		}
+		serialized := serializeRevisions(*revisions)
+		serialized["paywall"] = !entitled
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// GetRevision godoc
+// @Summary Get a revision of a post
+// @Description Retrieve a revision of a post by its revision number.
+// @Description The revisions of unpublished posts are only shown to their editors
+// @Description Readers who cannot read a members-only post get the previews of its revisions with paywall set
+// @Tags revisions
+// @ID get-revision
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/{id}/revisions/{rev} [get]
+func GetRevision(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		rev := convertToInt(c.Param("rev"))
+		if rev < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid revision.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+		entitled, ok := checkIfUserCanReadInFull(c, pool, post, env)
+		if !ok {
+			return
+		}
+
+		revision, err := db.GetRevision(c, pool, id, int(rev))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Revision not found.")
+			return
+		}
+		if !entitled {
+			revision = previewRevision(revision)
+		}
+		serialized := serializeRevision(revision)
+		serialized["paywall"] = !entitled
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// GetRevisionDiff godoc
+// @Summary Compare two revisions of a post
+// @Description Line-based diff of the documents of two revisions of a post.
+// @Description The revisions of unpublished posts are only shown to their editors
+// @Description Readers who cannot read a members-only post get the previews of its revisions with paywall set
+// @Tags revisions
+// @ID get-revision-diff
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param from query int true "Older revision number"
+// @Param to query int true "Newer revision number"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/{id}/diff [get]
+func GetRevisionDiff(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		from := convertToInt(c.Query("from"))
+		to := convertToInt(c.Query("to"))
+		if from < 1 || to < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid revision.")
+			return
+		}
+
+		post, err := findVisiblePost(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+		entitled, ok := checkIfUserCanReadInFull(c, pool, post, env)
+		if !ok {
+			return
+		}
+
+		fromRevision, err := db.GetRevision(c, pool, id, int(from))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Revision not found.")
+			return
+		}
+
+		toRevision, err := db.GetRevision(c, pool, id, int(to))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Revision not found.")
+			return
+		}
+
+		if !entitled {
+			fromRevision, toRevision = previewRevision(fromRevision), previewRevision(toRevision)
+		}
+
+		lines := diff.Lines(fromRevision.Document.String, toRevision.Document.String)
+		insertions, deletions := diff.Count(lines)
+		c.JSON(http.StatusOK, &response{
+			"from":       from,
+			"to":         to,
+			"insertions": insertions,
+			"deletions":  deletions,
+			"lines":      lines,
+			"paywall":    !entitled,
+		})
+	}
+}
+
+// RestoreRevision godoc
+// @Summary Restore a revision of a post
+// @Description Restores the content of an earlier revision as a new revision of the post
+// @Tags revisions
+// @ID restore-revision
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param rev path int true "Revision number"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/revisions/{rev}/restore [post]
+func RestoreRevision(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		rev := convertToInt(c.Param("rev"))
+		if rev < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid revision.")
+			return
+		}
+
+		queriedPost, err := db.GetPostByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if !checkIfUserCanEditPost(c, pool, queriedPost) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if _, err := db.GetRevision(c, pool, id, int(rev)); err != nil {
+			HandleError(c, http.StatusBadRequest, "Revision not found.")
+			return
+		}
+
+		username, _ := c.Get("username")
+		if post, err := db.RestoreRevision(c, pool, id, int(rev), username.(string)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update post in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePost(post))
+		}
+	}
+}
+
+// previewRevision returns a copy of a revision keeping the part of its document shown behind the paywall
+func previewRevision(r *models.PostRevision) *models.PostRevision {
+	preview := *r
+	preview.Document = null.StringFrom(db.PreviewDocument(r.Document.String))
+	return &preview
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// SchedulePost godoc
+// @Summary Schedule a post
+// @Description Schedules or reschedules an unpublished post to go live at the given time
+// @Tags posts
+// @ID schedule-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param schedule body api.PostScheduleForm true "Schedule Post"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/schedule [put]
+func SchedulePost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		var reqBody PostScheduleForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Publish time required.")
+			return
+		}
+
+		publishAt, err := parsePublishAt(reqBody.PublishAt)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid publish time.")
+			return
+		}
+
+		queriedPost, err := db.GetPostByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if !checkIfUserCanEditPost(c, pool, queriedPost) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if queriedPost.PublishedAt.Valid {
+			HandleError(c, http.StatusBadRequest, "Post already published.")
+			return
+		}
+
+		if post, err := db.SchedulePost(c, pool, id, publishAt); err == db.ErrPostPublished {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update post in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePost(post))
+		}
+	}
+}
+
+// CancelScheduledPost godoc
+// @Summary Cancel a scheduled post
+// @Description Cancels the publish time of an unpublished post, keeping it as a draft
+// @Tags posts
+// @ID cancel-scheduled-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/schedule [delete]
+func CancelScheduledPost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		queriedPost, err := db.GetPostByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if !checkIfUserCanEditPost(c, pool, queriedPost) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if queriedPost.PublishedAt.Valid {
+			HandleError(c, http.StatusBadRequest, "Post already published.")
+			return
+		}
+
+		if !queriedPost.PublishAt.Valid {
+			HandleError(c, http.StatusBadRequest, "Post is not scheduled.")
+			return
+		}
+
+		if post, err := db.UnschedulePost(c, pool, id); err == db.ErrPostPublished {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update post in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePost(post))
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// suggestBudget is how long suggestions may take.
+// Lookups still running when it runs out are left out of the response
+const suggestBudget = 150 * time.Millisecond
+
+// Number of suggestions returned
+const (
+	defaultSuggestions = 10
+	maxSuggestions     = 20
+)
+
+// searchFilters are the filters of GET /posts that also narrow down searches
+var searchFilters = []string{"tags", "author"}
+
+type suggester func(ctx context.Context, db *sql.DB, query string, limit int) ([]*db.Suggestion, error)
+
+// Search godoc
+// @Summary Search posts
+// @Description Full-text search over the title, tags and document of published posts.
+// @Description Supports web search syntax: "quoted phrases", OR and -excluded words
+// @Tags search
+// @ID search-posts
+// @Accept  json
+// @Produce  json
+// @Param q query string true "Search query"
+// @Param tags query string false "tags"
+// @Param author query string false "author"
+// @Param page query int false "Page number, starting at 1"
+// @Param per_page query int false "Results per page, at most 100"
+// @Success 200 {object} api.SwaggerSearchResults
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /search [get]
+func Search(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := strings.TrimSpace(c.Query("q"))
+		if q == "" {
+			HandleError(c, http.StatusBadRequest, "Query required.")
+			return
+		}
+
+		page, perPage, err := parsePagination(c)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid page.")
+			return
+		}
+
+		filter := &db.PostFilter{Status: db.StatusPublished}
+		fieldErrors := make(map[string]string)
+		queries := c.Request.URL.Query()
+		for _, key := range searchFilters {
+			if values, exists := queries[key]; exists {
+				if err := postFilterParsers[key](filter, values); err != nil {
+					fieldErrors[key] = err.Error()
+				}
+			}
+		}
+		if len(fieldErrors) > 0 {
+			HandleFieldErrors(c, http.StatusBadRequest, "Invalid filters.", fieldErrors)
+			return
+		}
+
+		search := &db.Search{
+			Query:  q,
+			Filter: filter,
+			Limit:  perPage,
+			Offset: (page - 1) * perPage,
+		}
+
+		results, total, err := db.SearchPosts(c, pool, search)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to search posts in DB.")
+			return
+		}
+
+		serialized := make([]response, len(results))
+		for i, r := range results {
+			serialized[i] = serializeSearchResult(r)
+		}
+		c.JSON(http.StatusOK, response{
+			"total_count": total,
+			"page":        page,
+			"per_page":    perPage,
+			"posts":       serialized,
+		})
+	}
+}
+
+// Suggest godoc
+// @Summary Suggest search terms
+// @Description Typo tolerant suggestions of tags, author handles and post titles for a partial query, best first.
+// @Description Lookups exceeding the latency budget are skipped and the response is marked partial
+// @Tags search
+// @ID suggest-search
+// @Accept  json
+// @Produce  json
+// @Param q query string true "Partial search query"
+// @Param limit query int false "Number of suggestions, at most 20"
+// @Success 200 {object} api.SwaggerSuggestions
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /search/suggest [get]
+func Suggest(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		q := strings.TrimSpace(c.Query("q"))
+		if q == "" {
+			HandleError(c, http.StatusBadRequest, "Query required.")
+			return
+		}
+
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(defaultSuggestions)))
+		if err != nil || limit < 1 || limit > maxSuggestions {
+			HandleError(c, http.StatusBadRequest, "Invalid limit.")
+			return
+		}
+
+		suggestions, partial, err := suggest(c, pool, q, limit)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve suggestions from DB.")
+			return
+		}
+
+		serialized := make([]response, len(suggestions))
+		for i, s := range suggestions {
+			serialized[i] = serializeSuggestion(s)
+		}
+		c.JSON(http.StatusOK, response{
+			"partial":     partial,
+			"suggestions": serialized,
+		})
+	}
+}
+
+// suggest runs the lookups for every type of suggestion at once within the latency budget
+// and merges their results by score. partial reports whether a lookup ran out of time
+func suggest(ctx context.Context, pool *sql.DB, q string, limit int) (suggestions []*db.Suggestion, partial bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, suggestBudget)
+	defer cancel()
+
+	type result struct {
+		suggestions []*db.Suggestion
+		err         error
+	}
+	suggesters := []suggester{db.SuggestTags, db.SuggestAuthors, db.SuggestTitles}
+	results := make(chan result, len(suggesters))
+	for _, s := range suggesters {
+		go func(s suggester) {
+			found, err := s(ctx, pool, q, limit)
+			results <- result{found, err}
+		}(s)
+	}
+
+	suggestions = []*db.Suggestion{}
+	for range suggesters {
+		r := <-results
+		if r.err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				partial = true
+				continue
+			}
+			return nil, false, r.err
+		}
+		suggestions = append(suggestions, r.suggestions...)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Score > suggestions[j].Score
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, partial, nil
+}
@@ -0,0 +1,236 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// CreateSeries godoc
+// @Summary Create a series
+// @Description Creates an empty series owned by the current user
+// @Tags series
+// @ID create-series
+// @Accept  json
+// @Produce  json
+// @Param series body api.SeriesForm true "Add series"
+// @Success 200 {object} api.SwaggerSeries
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /series [post]
+func CreateSeries(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody SeriesForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		reqBody.Title = strings.TrimSpace(reqBody.Title)
+		if err := validateStruct(&reqBody); err != nil || reqBody.Title == "" {
+			HandleError(c, http.StatusBadRequest, "Title required.")
+			return
+		}
+
+		username, _ := c.Get("username")
+		if series, err := db.CreateSeries(c, pool, username.(string), reqBody.Title, optionalText(reqBody.Description)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create series in DB.")
+		} else {
+			serialized := serializeSeries(series)
+			serialized["posts"] = []response{}
+			c.JSON(http.StatusOK, serialized)
+		}
+	}
+}
+
+// GetSeries godoc
+// @Summary Get a series
+// @Description Retrieve a series with its published posts in order. The author also sees unpublished parts
+// @Tags series
+// @ID get-series
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Series ID"
+// @Success 200 {object} api.SwaggerSeries
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /series/{id} [get]
+func GetSeries(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		series, ok := findSeries(c, pool)
+		if !ok {
+			return
+		}
+
+		posts, err := db.GetSeriesPosts(c, pool, series.ID, checkIfUserIsAuthor(c, series.Author))
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+			return
+		}
+
+		serialized := serializeSeries(series)
+		summaries := make([]response, len(*posts))
+		for i, p := range *posts {
+			summaries[i] = serializePostSummary(p)
+		}
+		serialized["posts"] = summaries
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// AddPostToSeries godoc
+// @Summary Add a post to a series
+// @Description Appends a post of the current user to their series. A post belongs to at most one series
+// @Tags series
+// @ID add-series-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Series ID"
+// @Param post body api.SeriesPostForm true "Post to add"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /series/{id}/posts [post]
+func AddPostToSeries(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody SeriesPostForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Post ID required.")
+			return
+		}
+
+		series, ok := findOwnSeries(c, pool)
+		if !ok {
+			return
+		}
+
+		post, err := db.GetPostByID(c, pool, int64(reqBody.PostID))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return
+		}
+
+		if !checkIfUserIsAuthor(c, post.Author.String) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if err := db.AddPostToSeries(c, pool, series.ID, post.ID); err == db.ErrPostInSeries {
+			HandleError(c, http.StatusBadRequest, "Post already belongs to a series.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to add post to series in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// RemovePostFromSeries godoc
+// @Summary Remove a post from a series
+// @Description Removes a post from a series of the current user
+// @Tags series
+// @ID remove-series-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Series ID"
+// @Param post_id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /series/{id}/posts/{post_id} [delete]
+func RemovePostFromSeries(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		postID := convertToInt(c.Param("post_id"))
+		if postID < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid post ID.")
+			return
+		}
+
+		series, ok := findOwnSeries(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.RemovePostFromSeries(c, pool, series.ID, int(postID)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to remove post from series in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// ReorderSeries godoc
+// @Summary Reorder a series
+// @Description Puts the posts of a series of the current user in the given order.
+// @Description The order must contain every post in the series once
+// @Tags series
+// @ID reorder-series
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Series ID"
+// @Param order body api.SeriesOrderForm true "Post IDs in order"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /series/{id}/posts [put]
+func ReorderSeries(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody SeriesOrderForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		series, ok := findOwnSeries(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.ReorderSeries(c, pool, series.ID, reqBody.PostIDs); err == db.ErrInvalidOrder {
+			HandleError(c, http.StatusBadRequest, "Invalid order.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to reorder series in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// findSeries returns the series in the path, handling the error when there is none
+func findSeries(c *gin.Context, pool *sql.DB) (*models.Series, bool) {
+	id := convertToInt(c.Param("id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	series, err := db.GetSeriesByID(c, pool, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Series not found.")
+		return nil, false
+	}
+	return series, true
+}
+
+// findOwnSeries returns the series in the path when the current user wrote it,
+// handling the error otherwise
+func findOwnSeries(c *gin.Context, pool *sql.DB) (*models.Series, bool) {
+	series, ok := findSeries(c, pool)
+	if !ok {
+		return nil, false
+	}
+	if !checkIfUserIsAuthor(c, series.Author) {
+		HandleError(c, http.StatusBadRequest, "User is not the author of the series.")
+		return nil, false
+	}
+	return series, true
+}
@@ -0,0 +1,337 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// CreateShareLink godoc
+// @Summary Share a draft
+// @Description Creates a secret link that opens an unpublished post without an account.
+// @Description The token is only returned here. Links may expire and may let reviewers comment
+// @Tags posts
+// @ID create-share-link
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param link body api.ShareLinkForm false "Link options"
+// @Success 200 {object} api.SwaggerShareLink
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/shares [post]
+func CreateShareLink(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ShareLinkForm
+		if c.Request.ContentLength > 0 {
+			if err := extractData(c, &reqBody); err != nil {
+				HandleError(c, http.StatusBadRequest, "Invalid request data.")
+				return
+			}
+		}
+
+		expiresAt, err := parsePublishAt(reqBody.ExpiresAt)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid expiry time.")
+			return
+		}
+
+		post, ok := findEditablePost(c, pool)
+		if !ok {
+			return
+		}
+		if post.PublishedAt.Valid {
+			HandleError(c, http.StatusBadRequest, "Post already published.")
+			return
+		}
+
+		link, token, err := db.CreateShareLink(c, pool, post.ID, reqBody.AllowComments, null.NewTime(expiresAt, !expiresAt.IsZero()))
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create share link in DB.")
+			return
+		}
+
+		serialized := serializeShareLink(link)
+		serialized["token"] = token
+		serialized["url"] = "/api/v1/shared/" + token
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// GetShareLinks godoc
+// @Summary Get the share links of a post
+// @Description Retrieve the share links of a post, newest first, with how often they were opened
+// @Tags posts
+// @ID get-share-links
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {object} api.SwaggerShareLinks
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/shares [get]
+func GetShareLinks(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		post, ok := findEditablePost(c, pool)
+		if !ok {
+			return
+		}
+
+		links, err := db.GetShareLinks(c, pool, post.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve share links from DB.")
+			return
+		}
+
+		serialized := make([]response, len(links))
+		for i, l := range links {
+			serialized[i] = serializeShareLink(&l.ShareLink)
+			serialized[i]["access_count"] = l.AccessCount
+			serialized[i]["last_accessed_at"] = l.LastAccessedAt
+		}
+		c.JSON(http.StatusOK, response{"links": serialized})
+	}
+}
+
+// GetShareLink godoc
+// @Summary Get a share link
+// @Description Retrieve a share link of a post with its access log and the comments left through it
+// @Tags posts
+// @ID get-share-link
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param share_id path int true "Share link ID"
+// @Success 200 {object} api.SwaggerShareLinkLog
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/shares/{share_id} [get]
+func GetShareLink(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		link, ok := findShareLink(c, pool)
+		if !ok {
+			return
+		}
+
+		accesses, err := db.GetShareLinkAccesses(c, pool, link.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve share link from DB.")
+			return
+		}
+		comments, err := db.GetShareLinkComments(c, pool, link.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve share link from DB.")
+			return
+		}
+
+		serializedAccesses := make([]response, len(accesses))
+		for i, a := range accesses {
+			serializedAccesses[i] = serializeShareLinkAccess(a)
+		}
+		serializedComments := make([]response, len(comments))
+		for i, comment := range comments {
+			serializedComments[i] = serializeShareLinkComment(comment)
+		}
+
+		serialized := serializeShareLink(link)
+		serialized["accesses"] = serializedAccesses
+		serialized["comments"] = serializedComments
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// RevokeShareLink godoc
+// @Summary Revoke a share link
+// @Description Stops a share link from opening its post. Its access log and comments are kept
+// @Tags posts
+// @ID revoke-share-link
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Param share_id path int true "Share link ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/shares/{share_id} [delete]
+func RevokeShareLink(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		link, ok := findShareLink(c, pool)
+		if !ok {
+			return
+		}
+
+		if err := db.RevokeShareLink(c, pool, link, time.Now()); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to revoke share link in DB.")
+		} else {
+			c.Status(http.StatusOK)
+		}
+	}
+}
+
+// GetSharedPost godoc
+// @Summary Read a shared draft
+// @Description Retrieve the post behind a share link, read-only and without an account.
+// @Description Links that allow comments list the comments left by reviewers
+// @Tags shared
+// @ID get-shared-post
+// @Accept  json
+// @Produce  json
+// @Param token path string true "Share token"
+// @Param format query string false "Document format: markdown (default), html, both or blocks"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /shared/{token} [get]
+func GetSharedPost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format := c.DefaultQuery("format", formatMarkdown)
+		if !checkIfFormatIsValid(format) {
+			HandleError(c, http.StatusBadRequest, "Invalid format.")
+			return
+		}
+
+		now := time.Now()
+		link, ok := openShareLink(c, pool, now)
+		if !ok {
+			return
+		}
+
+		if err := db.RecordShareLinkAccess(c, pool, link.ID, c.ClientIP(), c.Request.UserAgent(), now); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to record access in DB.")
+			return
+		}
+
+		serialized := serializePostInFormat(link.R.Post, format)
+		serialized["allow_comments"] = link.AllowComments
+		if link.AllowComments {
+			comments, err := db.GetShareLinkComments(c, pool, link.ID)
+			if err != nil {
+				HandleError(c, http.StatusInternalServerError, "Failed to retrieve comments from DB.")
+				return
+			}
+			serializedComments := make([]response, len(comments))
+			for i, comment := range comments {
+				serializedComments[i] = serializeShareLinkComment(comment)
+			}
+			serialized["comments"] = serializedComments
+		}
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// CommentOnSharedPost godoc
+// @Summary Comment on a shared draft
+// @Description Leaves feedback on the post behind a share link that allows comments,
+// @Description in reply to another comment of the link when parent_id is set.
+// @Description Signed in commenters are notified of the replies to their comments
+// @Tags shared
+// @ID comment-on-shared-post
+// @Accept  json
+// @Produce  json
+// @Param token path string true "Share token"
+// @Param comment body api.ShareCommentForm true "Comment"
+// @Success 200 {object} api.SwaggerShareComment
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /shared/{token}/comments [post]
+func CommentOnSharedPost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody ShareCommentForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		reqBody.Name = strings.TrimSpace(reqBody.Name)
+		reqBody.Body = strings.TrimSpace(reqBody.Body)
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Name, Body required.")
+			return
+		}
+
+		link, ok := openShareLink(c, pool, time.Now())
+		if !ok {
+			return
+		}
+		if !link.AllowComments {
+			HandleError(c, http.StatusBadRequest, "Comments are not allowed.")
+			return
+		}
+
+		userID := null.Int{}
+		if user, err := getCurrentUser(c, pool); err == nil {
+			userID = null.IntFrom(user.ID)
+		}
+		parentID := null.NewInt(reqBody.ParentID, reqBody.ParentID > 0)
+
+		if comment, err := db.AddShareLinkComment(c, pool, link.ID, userID, parentID, reqBody.Name, reqBody.Body); err == db.ErrCommentNotFound {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create comment in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeShareLinkComment(comment))
+		}
+	}
+}
+
+// findEditablePost returns the post in the path when the current user may edit it,
+// handling the error otherwise
+func findEditablePost(c *gin.Context, pool *sql.DB) (*models.Post, bool) {
+	id := convertToInt(c.Param("id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid ID.")
+		return nil, false
+	}
+
+	post, err := db.GetPostByID(c, pool, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Post not found.")
+		return nil, false
+	}
+	if !checkIfUserCanEditPost(c, pool, post) {
+		HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+		return nil, false
+	}
+	return post, true
+}
+
+// findShareLink returns the share link in the path of a post the current user may edit,
+// handling the error otherwise
+func findShareLink(c *gin.Context, pool *sql.DB) (*models.ShareLink, bool) {
+	post, ok := findEditablePost(c, pool)
+	if !ok {
+		return nil, false
+	}
+
+	id := convertToInt(c.Param("share_id"))
+	if id < 1 {
+		HandleError(c, http.StatusBadRequest, "Invalid share link ID.")
+		return nil, false
+	}
+
+	link, err := db.GetShareLink(c, pool, post.ID, id)
+	if err != nil {
+		HandleError(c, http.StatusBadRequest, "Share link not found.")
+		return nil, false
+	}
+	return link, true
+}
+
+// openShareLink returns the share link with the token in the path, handling the error
+// when it is unknown, revoked or expired
+func openShareLink(c *gin.Context, pool *sql.DB, now time.Time) (*models.ShareLink, bool) {
+	link, err := db.FindShareLink(c, pool, c.Param("token"), now)
+	if err == db.ErrLinkExpired {
+		HandleError(c, http.StatusBadRequest, err.Error())
+		return nil, false
+	} else if err != nil {
+		HandleError(c, http.StatusBadRequest, "Share link not found.")
+		return nil, false
+	}
+	return link, true
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// maxStreamTopics is the maximum number of topics a stream subscribes to
+const maxStreamTopics = 50
+
+// streamRetry is how long in milliseconds clients wait before reconnecting to a closed stream
+const streamRetry = 3000
+
+// postTopic matches the topics of a post a client may subscribe to
+var postTopic = regexp.MustCompile(`^post:(\d+):(likes|comments)$`)
+
+// Stream godoc
+// @Summary Stream real-time updates
+// @Description Pushes server-sent events on the comma separated topics: `notifications` of the current user,
+// @Description `post:{id}:likes` with the like count of a post and `post:{id}:comments` with the comments
+// @Description left on a post the user edits. A comment line is sent as a heartbeat while there are no events.
+// @Description Sending the Last-Event-ID header resumes the stream after that event while it is buffered,
+// @Description otherwise a reset event tells the client to refetch. Clients falling behind are disconnected
+// @Tags stream
+// @ID stream
+// @Produce  text/event-stream
+// @Param topics query string true "Topics"
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Success 200 {string} string "Event stream"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /stream [get]
+func Stream(pool *sql.DB, env *config.EnvVars, broker *stream.Broker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+		topics, ok := parseStreamTopics(c, pool, user)
+		if !ok {
+			return
+		}
+
+		var lastEventID int64
+		if header := c.GetHeader("Last-Event-ID"); header != "" {
+			lastEventID, err = strconv.ParseInt(header, 10, 64)
+			if err != nil || lastEventID < 1 {
+				HandleError(c, http.StatusBadRequest, "Invalid Last-Event-ID.")
+				return
+			}
+		}
+
+		sub, replay, resumed := broker.Subscribe(topics, lastEventID)
+		defer broker.Unsubscribe(sub)
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		// Stops proxies from buffering the stream
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		w := c.Writer
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetry)
+		if !resumed {
+			fmt.Fprintf(w, "event: %s\ndata: {}\n\n", stream.EventReset)
+		}
+		for _, e := range replay {
+			writeStreamEvent(w, e)
+		}
+		w.Flush()
+
+		heartbeat := time.NewTicker(time.Duration(env.StreamHeartbeatSecs) * time.Second)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case e, ok := <-sub.Events():
+				// The client fell behind and resumes from the replay buffer once it reconnects
+				if !ok {
+					return
+				}
+				writeStreamEvent(w, e)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+			w.Flush()
+		}
+	}
+}
+
+// parseStreamTopics returns the topics in the query the current user may subscribe to,
+// handling the error otherwise
+func parseStreamTopics(c *gin.Context, pool *sql.DB, user *models.User) ([]string, bool) {
+	names := strings.Split(c.Query("topics"), ",")
+	if len(names) > maxStreamTopics {
+		HandleError(c, http.StatusBadRequest, "Too many topics.")
+		return nil, false
+	}
+
+	topics := []string{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "notifications" {
+			topics = append(topics, stream.NotificationsTopic(user.ID))
+			continue
+		}
+
+		match := postTopic.FindStringSubmatch(name)
+		if match == nil {
+			HandleError(c, http.StatusBadRequest, "Invalid topic.")
+			return nil, false
+		}
+		post, err := findVisiblePost(c, pool, convertToInt(match[1]))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found.")
+			return nil, false
+		}
+		if match[2] == "comments" {
+			// Comments are left through share links, which only the authors see
+			if !checkIfUserCanEditPost(c, pool, post) {
+				HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+				return nil, false
+			}
+			topics = append(topics, stream.PostCommentsTopic(post.ID))
+		} else {
+			topics = append(topics, stream.PostLikesTopic(post.ID))
+		}
+	}
+	return topics, true
+}
+
+// writeStreamEvent writes an event in the server-sent events format
+func writeStreamEvent(w gin.ResponseWriter, e stream.Event) {
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/payments"
+)
+
+// Subscribe godoc
+// @Summary Subscribe
+// @Description Charges the current user for a subscription that gives access to members-only posts.
+// @Description The local fake provider declines the token tok_declined and accepts any other
+// @Tags subscriptions
+// @ID subscribe
+// @Accept  json
+// @Produce  json
+// @Param subscription body api.SubscriptionForm true "Plan and payment token"
+// @Success 200 {object} api.SwaggerSubscription
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 402 {object} api.APIError "Payment Required"
+// @Router /subscriptions [post]
+func Subscribe(pool *sql.DB, provider payments.Provider) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody SubscriptionForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Tier, Period, Token required.")
+			return
+		}
+
+		if reqBody.Tier != db.TierMember && reqBody.Tier != db.TierPatron {
+			HandleError(c, http.StatusBadRequest, "Invalid tier.")
+			return
+		}
+		if reqBody.Period != payments.Monthly && reqBody.Period != payments.Yearly {
+			HandleError(c, http.StatusBadRequest, "Invalid period.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		subscribed, err := db.HasValidSubscription(c, pool, user.ID, time.Now())
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve subscription from DB.")
+			return
+		}
+		if subscribed {
+			HandleError(c, http.StatusBadRequest, "Already subscribed.")
+			return
+		}
+
+		plan := payments.Plan{Tier: reqBody.Tier, Period: reqBody.Period}
+		charged, err := provider.Subscribe(c, user.Email.String, plan, reqBody.Token)
+		if err == payments.ErrDeclined {
+			HandleError(c, http.StatusPaymentRequired, err.Error())
+			return
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to reach the payment provider.")
+			return
+		}
+
+		subscription, err := db.SaveSubscription(c, pool, user.ID, plan.Tier, plan.Period, charged.ID, charged.CurrentPeriodEnd)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create subscription in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeSubscription(subscription))
+		}
+	}
+}
+
+// GetSubscription godoc
+// @Summary Get the subscription of the current user
+// @Description Retrieve the subscription of the current user, including expired and canceled ones
+// @Tags subscriptions
+// @ID get-subscription
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerSubscription
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /subscriptions/me [get]
+func GetSubscription(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if subscription, err := db.GetSubscription(c, pool, user.ID); err != nil {
+			HandleError(c, http.StatusBadRequest, "Subscription not found.")
+		} else {
+			c.JSON(http.StatusOK, serializeSubscription(subscription))
+		}
+	}
+}
+
+// CancelSubscription godoc
+// @Summary Cancel the subscription of the current user
+// @Description Stops renewing the subscription. Members-only posts stay readable until the end of the paid period
+// @Tags subscriptions
+// @ID cancel-subscription
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerSubscription
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /subscriptions/me [delete]
+func CancelSubscription(pool *sql.DB, provider payments.Provider) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		subscription, err := db.GetSubscription(c, pool, user.ID)
+		if err != nil || subscription.Status != db.SubscriptionActive {
+			HandleError(c, http.StatusBadRequest, "Subscription not found.")
+			return
+		}
+
+		if err := provider.Cancel(c, subscription.ProviderID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to reach the payment provider.")
+			return
+		}
+
+		if err := db.CancelSubscription(c, pool, subscription, time.Now()); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to cancel subscription in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeSubscription(subscription))
+		}
+	}
+}
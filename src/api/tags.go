@@ -0,0 +1,303 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// GetTags godoc
+// @Summary Get tags
+// @Description Retrieve the tags of published posts with their post counts, most used first
+// @Tags tags
+// @ID get-tags
+// @Accept  json
+// @Produce  json
+// @Param page query int false "Page number, starting at 1"
+// @Param per_page query int false "Tags per page, at most 100"
+// @Success 200 {object} api.SwaggerTags
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /tags [get]
+func GetTags(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, perPage, err := parsePagination(c)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid page.")
+			return
+		}
+
+		tags, err := db.GetTags(c, pool, perPage, (page-1)*perPage)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tags from DB.")
+			return
+		}
+		total, err := db.CountTags(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tags from DB.")
+			return
+		}
+
+		serialized := make([]response, len(tags))
+		for i, t := range tags {
+			serialized[i] = serializeTag(&t.Tag)
+			serialized[i]["post_count"] = t.PostCount
+		}
+		c.JSON(http.StatusOK, response{
+			"total_count": total,
+			"page":        page,
+			"per_page":    perPage,
+			"tags":        serialized,
+		})
+	}
+}
+
+// GetTag godoc
+// @Summary Get tag
+// @Description Retrieve a tag by its slug or one of its aliases, with a page of its posts
+// @Tags tags
+// @ID get-tag
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Tag slug"
+// @Param page query int false "Page number, starting at 1"
+// @Param per_page query int false "Posts per page, at most 100"
+// @Success 200 {object} api.SwaggerTagPage
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /tags/{slug} [get]
+func GetTag(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, perPage, err := parsePagination(c)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid page.")
+			return
+		}
+
+		tag, err := db.GetTagBySlug(c, pool, c.Param("slug"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Tag not found.")
+			return
+		}
+
+		postCount, err := db.CountPostsWithTag(c, pool, tag.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tag from DB.")
+			return
+		}
+		followerCount, err := db.CountTagFollowers(c, pool, tag.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tag from DB.")
+			return
+		}
+		aliases, err := db.GetTagAliases(c, pool, tag.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tag from DB.")
+			return
+		}
+		posts, err := db.GetPostsWithTag(c, pool, tag.ID, perPage, (page-1)*perPage)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+			return
+		}
+
+		aliasNames := make([]string, len(*aliases))
+		for i, a := range *aliases {
+			aliasNames[i] = a.Alias
+		}
+		serializedPosts := make([]response, len(*posts))
+		for i, p := range *posts {
+			serializedPosts[i] = serializePostSummary(p)
+		}
+
+		serialized := serializeTag(tag)
+		serialized["post_count"] = postCount
+		serialized["follower_count"] = followerCount
+		serialized["aliases"] = aliasNames
+		serialized["page"] = page
+		serialized["per_page"] = perPage
+		serialized["posts"] = serializedPosts
+		c.JSON(http.StatusOK, serialized)
+	}
+}
+
+// FollowTag godoc
+// @Summary Follow a tag
+// @Description Adds a tag to the tags the current user follows
+// @Tags tags
+// @ID follow-tag
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Tag slug"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /tags/{slug}/follow [post]
+func FollowTag(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tag, err := db.GetTagBySlug(c, pool, c.Param("slug"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Tag not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.FollowTag(c, pool, user.ID, tag.ID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to follow tag in DB.")
+		} else {
+			serialized := serializeTag(tag)
+			serialized["following"] = true
+			c.JSON(http.StatusOK, serialized)
+		}
+	}
+}
+
+// UnfollowTag godoc
+// @Summary Unfollow a tag
+// @Description Removes a tag from the tags the current user follows
+// @Tags tags
+// @ID unfollow-tag
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Tag slug"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /tags/{slug}/follow [delete]
+func UnfollowTag(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tag, err := db.GetTagBySlug(c, pool, c.Param("slug"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Tag not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if err := db.UnfollowTag(c, pool, user.ID, tag.ID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to unfollow tag in DB.")
+		} else {
+			serialized := serializeTag(tag)
+			serialized["following"] = false
+			c.JSON(http.StatusOK, serialized)
+		}
+	}
+}
+
+// GetFollowedTags godoc
+// @Summary Get followed tags
+// @Description Retrieve the tags a user follows
+// @Tags users
+// @ID get-followed-tags
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerTags
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /users/{id}/tags [get]
+func GetFollowedTags(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		if _, err := db.GetUserByID(c, pool, id); err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		if tags, err := db.GetFollowedTags(c, pool, int(id)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve tags from DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeTags(*tags))
+		}
+	}
+}
+
+// AddTagAlias godoc
+// @Summary Add a tag alias
+// @Description Makes another slug resolve to a tag. An existing tag with that slug is merged into the tag. Admins only
+// @Tags tags
+// @ID add-tag-alias
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Tag slug"
+// @Param alias body api.TagAliasForm true "Alias"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 403 {object} api.APIError "Forbidden"
+// @Router /tags/{slug}/aliases [post]
+func AddTagAlias(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody TagAliasForm
+		if err := extractData(c, &reqBody); err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid request data.")
+			return
+		}
+
+		if err := validateStruct(&reqBody); err != nil || db.Slugify(reqBody.Alias) == "" {
+			HandleError(c, http.StatusBadRequest, "Alias required.")
+			return
+		}
+
+		tag, err := db.GetTagBySlug(c, pool, c.Param("slug"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Tag not found.")
+			return
+		}
+
+		alias, err := db.AddTagAlias(c, pool, tag, reqBody.Alias)
+		if err == db.ErrAliasIsTag {
+			HandleError(c, http.StatusBadRequest, "Alias is the slug of the tag.")
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to add alias in DB.")
+		} else {
+			serialized := serializeTag(tag)
+			serialized["alias"] = alias.Alias
+			c.JSON(http.StatusOK, serialized)
+		}
+	}
+}
+
+// RemoveTagAlias godoc
+// @Summary Remove a tag alias
+// @Description Stops a slug from resolving to a tag. Admins only
+// @Tags tags
+// @ID remove-tag-alias
+// @Accept  json
+// @Produce  json
+// @Param slug path string true "Tag slug"
+// @Param alias path string true "Alias"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 403 {object} api.APIError "Forbidden"
+// @Router /tags/{slug}/aliases/{alias} [delete]
+func RemoveTagAlias(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tag, err := db.GetTagBySlug(c, pool, c.Param("slug"))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Tag not found.")
+			return
+		}
+
+		if err := db.RemoveTagAlias(c, pool, tag.ID, c.Param("alias")); err != nil {
+			HandleError(c, http.StatusBadRequest, "Alias not found.")
+		} else {
+			c.JSON(http.StatusOK, serializeTag(tag))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// GetTrash godoc
+// @Summary Get deleted posts
+// @Description Retrieve the posts of the current user that are in the trash
+// @Tags users
+// @ID get-trash
+// @Accept  json
+// @Produce  json
+// @Param id path string true "me"
+// @Success 200 {object} api.SwaggerPosts
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/trash [get]
+func GetTrash(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param("id") != "me" {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		username, exists := c.Get("username")
+		if !exists {
+			HandleError(c, http.StatusBadRequest, "Username not found.")
+			return
+		}
+
+		if posts, err := db.GetDeletedPostsByAuthor(c, pool, username.(string)); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve posts from DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePosts(*posts))
+		}
+	}
+}
+
+// RestorePost godoc
+// @Summary Restore a deleted post
+// @Description Takes a post out of the trash
+// @Tags posts
+// @ID restore-post
+// @Accept  json
+// @Produce  json
+// @Param id path int true "Post ID"
+// @Success 200 {string} string	"ok"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /posts/{id}/restore [post]
+func RestorePost(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id := convertToInt(idStr)
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+
+		queriedPost, err := db.GetDeletedPostByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Post not found in trash.")
+			return
+		}
+
+		if !checkIfUserIsAuthor(c, queriedPost.Author.String) {
+			HandleError(c, http.StatusBadRequest, "User is not the author of the post.")
+			return
+		}
+
+		if post, err := db.RestorePostByID(c, pool, id); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update post in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializePost(post))
+		}
+	}
+}
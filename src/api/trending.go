@@ -0,0 +1,69 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+const defaultTrendingWindow = "week"
+
+// GetTrendingPosts godoc
+// @Summary Get trending posts
+// @Description Ranking of the posts published within a window by their likes, comments and views,
+// @Description with recent activity weighing more. Rankings are recomputed every few minutes
+// @Tags posts
+// @ID get-trending-posts
+// @Accept  json
+// @Produce  json
+// @Param window query string false "day, week (default) or month"
+// @Param tag query string false "Only posts with the tag"
+// @Param page query int false "Page number, starting at 1"
+// @Param per_page query int false "Results per page, at most 100"
+// @Success 200 {object} api.SwaggerTrendingPosts
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /posts/trending [get]
+func GetTrendingPosts(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		window := c.DefaultQuery("window", defaultTrendingWindow)
+		if _, ok := db.GetTrendingPeriod(window); !ok {
+			HandleError(c, http.StatusBadRequest, "Invalid window.")
+			return
+		}
+
+		page, perPage, err := parsePagination(c)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Invalid page.")
+			return
+		}
+
+		tag := db.Slugify(c.Query("tag"))
+		if tag != "" {
+			// Aliases rank with the tag they point to
+			if found, err := db.GetTagBySlug(c, pool, tag); err == nil {
+				tag = found.Slug
+			}
+		}
+
+		trending, err := db.GetTrendingPosts(c, pool, window, tag, perPage, (page-1)*perPage)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve trending posts from DB.")
+			return
+		}
+
+		serialized := make([]response, len(trending))
+		for i, t := range trending {
+			serialized[i] = serializeTrendingPost(t)
+		}
+		c.JSON(http.StatusOK, response{
+			"window":   window,
+			"tag":      tag,
+			"page":     page,
+			"per_page": perPage,
+			"posts":    serialized,
+		})
+	}
+}
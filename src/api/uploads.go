@@ -0,0 +1,315 @@
+package api
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/images"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
+)
+
+// imageExtensions maps the image types accepted for upload to the extension of their URLs
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// UploadImage godoc
+// @Summary Upload an image
+// @Description Stores an image for use in posts. The type is sniffed from the content, not taken from the request.
+// @Description The image is stored without its metadata, upright according to its EXIF orientation,
+// @Description along with narrower variants for srcset and a blur hash placeholder.
+// @Description Images are content-addressed, so uploading the same image twice returns the same URL
+// @Tags uploads
+// @ID upload-image
+// @Accept  mpfd
+// @Produce  json
+// @Param file formData file true "JPEG, PNG, GIF or WebP image"
+// @Success 200 {object} api.SwaggerUpload
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 413 {object} api.APIError "Request Entity Too Large"
+// @Failure 415 {object} api.APIError "Unsupported Media Type"
+// @Router /uploads [post]
+func UploadImage(pool *sql.DB, store storage.BlobStore, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := int64(env.UploadMaxBytes)
+		// Leaves room for the multipart headers around the file
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit+64<<10)
+
+		header, err := c.FormFile("file")
+		if err != nil {
+			if strings.Contains(err.Error(), "request body too large") {
+				HandleError(c, http.StatusRequestEntityTooLarge, "File too large.")
+			} else {
+				HandleError(c, http.StatusBadRequest, "File required.")
+			}
+			return
+		}
+		if header.Size > limit {
+			HandleError(c, http.StatusRequestEntityTooLarge, "File too large.")
+			return
+		}
+
+		file, err := header.Open()
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "File required.")
+			return
+		}
+		defer file.Close()
+
+		data, err := ioutil.ReadAll(io.LimitReader(file, limit+1))
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "File required.")
+			return
+		}
+		if int64(len(data)) > limit {
+			HandleError(c, http.StatusRequestEntityTooLarge, "File too large.")
+			return
+		}
+
+		contentType := http.DetectContentType(data)
+		if _, ok := imageExtensions[contentType]; !ok {
+			HandleError(c, http.StatusUnsupportedMediaType, "Unsupported file type.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		processed, err := images.Process(data, env.ImageVariantWidths)
+		if err == images.ErrTooManyPixels {
+			HandleError(c, http.StatusRequestEntityTooLarge, err.Error())
+			return
+		} else if err != nil {
+			HandleError(c, http.StatusBadRequest, images.ErrInvalidImage.Error())
+			return
+		}
+		original := processed.Original
+
+		quota := int64(env.UploadQuotaBytes)
+		if used, err := db.GetUploadUsage(c, pool, user.ID); err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve uploads from DB.")
+			return
+		} else if used+int64(len(original.Data)) > quota {
+			HandleError(c, http.StatusBadRequest, db.ErrQuotaExceeded.Error())
+			return
+		}
+
+		sum := sha256.Sum256(original.Data)
+		hash := hex.EncodeToString(sum[:])
+		variants := make(models.UploadVariantSlice, len(processed.Variants))
+		for i, v := range processed.Variants {
+			variants[i] = &models.UploadVariant{Width: v.Width, Height: v.Height, ContentType: v.ContentType, Size: int64(len(v.Data))}
+		}
+
+		var storeErr error
+		upload, err := db.CreateUpload(c, pool, &models.Upload{
+			UserID:      user.ID,
+			Hash:        hash,
+			ContentType: original.ContentType,
+			Size:        int64(len(original.Data)),
+			Width:       original.Width,
+			Height:      original.Height,
+			BlurHash:    processed.BlurHash,
+		}, variants, quota, func() error {
+			storeErr = store.Put(c, storage.ImageKey(hash, 0), original.Data, original.ContentType)
+			for _, v := range processed.Variants {
+				if storeErr != nil {
+					break
+				}
+				storeErr = store.Put(c, storage.ImageKey(hash, v.Width), v.Data, v.ContentType)
+			}
+			return storeErr
+		})
+		if storeErr != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to store file.")
+			return
+		}
+		if err == db.ErrQuotaExceeded {
+			HandleError(c, http.StatusBadRequest, err.Error())
+		} else if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to create upload in DB.")
+		} else {
+			c.JSON(http.StatusOK, serializeUpload(upload))
+		}
+	}
+}
+
+// GetUploads godoc
+// @Summary Get the uploads of the current user
+// @Description Retrieve the images uploaded by the current user, newest first, with their storage usage and quota in bytes
+// @Tags uploads
+// @ID get-uploads
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerUploads
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /uploads [get]
+func GetUploads(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		uploads, err := db.GetUserUploads(c, pool, user.ID)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve uploads from DB.")
+			return
+		}
+
+		var used int64
+		serialized := make([]response, len(uploads))
+		for i, u := range uploads {
+			used += u.Size
+			serialized[i] = serializeUpload(u)
+		}
+		c.JSON(http.StatusOK, response{"used": used, "quota": env.UploadQuotaBytes, "uploads": serialized})
+	}
+}
+
+// GetUpload godoc
+// @Summary Get an uploaded image
+// @Description Serves an uploaded image, or one of its variants, by its content-addressed name.
+// @Description The content of a name never changes, so responses may be cached forever
+// @Tags uploads
+// @ID get-upload
+// @Produce  image/jpeg,image/png,image/gif
+// @Param name path string true "Hash of the image, followed by -w and the width for a variant, with its extension"
+// @Success 200 {file} file "Image"
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Router /uploads/{name} [get]
+func GetUpload(pool *sql.DB, store storage.BlobStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		match := uploadName.FindStringSubmatch(c.Param("name"))
+		if match == nil {
+			HandleError(c, http.StatusBadRequest, "Upload not found.")
+			return
+		}
+		hash, ext := match[1], match[3]
+		width, _ := strconv.Atoi(match[2])
+
+		upload, err := db.GetUploadByHash(c, pool, hash)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "Upload not found.")
+			return
+		}
+		contentType, size := upload.ContentType, upload.Size
+		if width > 0 {
+			variant := findVariant(upload, width)
+			if variant == nil {
+				HandleError(c, http.StatusBadRequest, "Upload not found.")
+				return
+			}
+			contentType, size = variant.ContentType, variant.Size
+		}
+		if ext != imageExtensions[contentType] {
+			HandleError(c, http.StatusBadRequest, "Upload not found.")
+			return
+		}
+
+		etag := `"` + strings.TrimSuffix(match[0], ext) + `"`
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Header("ETag", etag)
+		c.Header("X-Content-Type-Options", "nosniff")
+		if c.GetHeader("If-None-Match") == etag {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		blob, err := store.Get(c, storage.ImageKey(hash, width))
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to read file.")
+			return
+		}
+		defer blob.Close()
+		c.DataFromReader(http.StatusOK, size, contentType, blob, nil)
+	}
+}
+
+// uploadName matches the name of an upload: its hash, the width of a variant and the extension
+var uploadName = regexp.MustCompile(`^([0-9a-f]{64})(?:-w([1-9][0-9]*))?(\.[a-z]+)$`)
+
+// uploadURL returns the content-addressed URL of an upload
+func uploadURL(u *models.Upload) string {
+	return "/api/v1/uploads/" + u.Hash + imageExtensions[u.ContentType]
+}
+
+// variantURL returns the content-addressed URL of a variant of an upload
+func variantURL(u *models.Upload, v *models.UploadVariant) string {
+	return fmt.Sprintf("/api/v1/uploads/%s-w%d%s", u.Hash, v.Width, imageExtensions[v.ContentType])
+}
+
+func findVariant(u *models.Upload, width int) *models.UploadVariant {
+	if u.R == nil {
+		return nil
+	}
+	for _, v := range u.R.UploadVariants {
+		if v.Width == width {
+			return v
+		}
+	}
+	return nil
+}
+
+// GetOrphanedUploads godoc
+// @Summary Report the uploads the sweeper would delete
+// @Description Dry run of the upload sweeper: lists the uploads older than the grace period whose image
+// @Description no post or revision of a post embeds, and the blobs that would be deleted with them, without deleting anything
+// @Tags uploads
+// @ID get-orphaned-uploads
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} api.SwaggerUploadSweep
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Failure 403 {object} api.APIError "Forbidden"
+// @Router /admin/uploads/orphans [get]
+func GetOrphanedUploads(pool *sql.DB, env *config.EnvVars) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		before := time.Now().Add(-time.Duration(env.UploadGraceHours) * time.Hour)
+		sweep, err := db.FindOrphanedUploads(c, pool, before)
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to retrieve uploads from DB.")
+			return
+		}
+
+		uploads := make([]response, len(sweep.Uploads))
+		for i, u := range sweep.Uploads {
+			uploads[i] = serializeUpload(u)
+			uploads[i]["user_id"] = u.UserID
+		}
+		var size int64
+		blobs := make([]response, len(sweep.Blobs))
+		for i, b := range sweep.Blobs {
+			size += b.Size
+			widths := b.Widths
+			if widths == nil {
+				widths = []int{}
+			}
+			blobs[i] = response{"hash": b.Hash, "widths": widths, "size": b.Size}
+		}
+		c.JSON(http.StatusOK, response{"before": before, "uploads": uploads, "blobs": blobs, "size": size})
+	}
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// BlockUser godoc
+// @Summary Block a user
+// @Description Blocks a user from mentioning the current user. The blocked user stops following the current user
+// @Tags users
+// @ID block-user
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerBlock
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/block [post]
+func BlockUser(pool *sql.DB) gin.HandlerFunc {
+	return changeBlock(pool, true)
+}
+
+// UnblockUser godoc
+// @Summary Unblock a user
+// @Description Lets a blocked user mention the current user again
+// @Tags users
+// @ID unblock-user
+// @Accept  json
+// @Produce  json
+// @Param id path int true "User ID"
+// @Success 200 {object} api.SwaggerBlock
+// @Failure 400 {object} api.APIError "Bad Request"
+// @Failure 401 {object} api.APIError "Unauthorized"
+// @Router /users/{id}/block [delete]
+func UnblockUser(pool *sql.DB) gin.HandlerFunc {
+	return changeBlock(pool, false)
+}
+
+// changeBlock returns a handler making the current user block or unblock a user
+func changeBlock(pool *sql.DB, block bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := convertToInt(c.Param("id"))
+		if id < 1 {
+			HandleError(c, http.StatusBadRequest, "Invalid ID.")
+			return
+		}
+		blocked, err := db.GetUserByID(c, pool, id)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+
+		user, err := getCurrentUser(c, pool)
+		if err != nil {
+			HandleError(c, http.StatusBadRequest, "User not found.")
+			return
+		}
+		if user.ID == blocked.ID {
+			HandleError(c, http.StatusBadRequest, "Users cannot block themselves.")
+			return
+		}
+
+		if block {
+			err = db.BlockUser(c, pool, user.ID, blocked.ID)
+		} else {
+			err = db.UnblockUser(c, pool, user.ID, blocked.ID)
+		}
+		if err != nil {
+			HandleError(c, http.StatusInternalServerError, "Failed to update blocks in DB.")
+			return
+		}
+		c.JSON(http.StatusOK, response{"user_id": blocked.ID, "blocking": block})
+	}
+}
@@ -1,38 +1,445 @@
 package api
 
 import (
+	"database/sql"
+	"encoding/json"
 	"errors"
-	"net/url"
+	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"github.com/volatiletech/null/v8"
 
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+	"github.com/json9512/mediumclone-backendwithgo/src/config"
 	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
 	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/summary"
+)
+
+// Formats in which a post document can be returned
+const (
+	formatMarkdown = "markdown"
+	formatHTML     = "html"
+	formatBoth     = "both"
+	formatBlocks   = "blocks"
+)
+
+// Page sizes of paginated responses
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
 )
 
 type PostInsertForm struct {
-	Doc      string `json:"doc" validate:"required" example:"some-text"`
-	Tags     string `json:"tags" example:"some,tags,here"`
-	Likes    uint   `json:"likes" example:"123"`
-	Comments string `json:"comments" example:"some-comment"`
+	Title       string          `json:"title" example:"some-title"`
+	Doc         string          `json:"doc" example:"some-text"`
+	Blocks      json.RawMessage `json:"blocks" swaggertype:"object"`
+	Tags        string          `json:"tags" example:"some,tags,here"`
+	Comments    string          `json:"comments" example:"some-comment"`
+	PublishAt   string          `json:"publish_at" example:"2021-05-01T09:00:00Z"`
+	MembersOnly bool            `json:"members_only" example:"false"`
 }
 
 type PostUpdateForm struct {
-	ID       int    `json:"id" validate:"required" example:"1"`
-	Doc      string `json:"doc" example:"some-text"`
-	Tags     string `json:"tags" example:"some,tags,here"`
-	Likes    uint   `json:"likes" example:"123"`
-	Comments string `json:"comments" example:"some-comment"`
+	ID          int             `json:"id" validate:"required" example:"1"`
+	Title       string          `json:"title" example:"some-title"`
+	Doc         string          `json:"doc" example:"some-text"`
+	Blocks      json.RawMessage `json:"blocks" swaggertype:"object"`
+	Tags        string          `json:"tags" example:"some,tags,here"`
+	Comments    string          `json:"comments" example:"some-comment"`
+	MembersOnly *bool           `json:"members_only" example:"true"`
+}
+
+type PostScheduleForm struct {
+	PublishAt string `json:"publish_at" validate:"required" example:"2021-05-01T09:00:00Z"`
+}
+
+type SwaggerSearchResult struct {
+	SwaggerPostSummary
+	Rank    float32 `json:"rank" example:"0.6"`
+	Snippet string  `json:"snippet" example:"… the <mark>matching</mark> words …"`
+}
+
+type SwaggerSearchResults struct {
+	TotalCount int                   `json:"total_count"`
+	Page       int                   `json:"page"`
+	PerPage    int                   `json:"per_page"`
+	Posts      []SwaggerSearchResult `json:"posts"`
+}
+
+type SwaggerSuggestion struct {
+	Type   string  `json:"type" example:"tag"`
+	Text   string  `json:"text" example:"golang"`
+	PostID int     `json:"post_id,omitempty" example:"1"`
+	Score  float32 `json:"score" example:"0.8"`
+}
+
+type SwaggerSuggestions struct {
+	Partial     bool                `json:"partial"`
+	Suggestions []SwaggerSuggestion `json:"suggestions"`
+}
+
+type SwaggerTrendingPost struct {
+	SwaggerPostSummary
+	Score float64 `json:"score" example:"12.5"`
+	Rank  int     `json:"rank" example:"1"`
+}
+
+type SwaggerTrendingPosts struct {
+	Window  string                `json:"window" example:"week"`
+	Tag     string                `json:"tag,omitempty" example:"go"`
+	Page    int                   `json:"page"`
+	PerPage int                   `json:"per_page"`
+	Posts   []SwaggerTrendingPost `json:"posts"`
+}
+
+type TagAliasForm struct {
+	Alias string `json:"alias" validate:"required" example:"golang"`
+}
+
+type SwaggerTag struct {
+	Slug      string `json:"slug" example:"go"`
+	Name      string `json:"name" example:"Go"`
+	PostCount int    `json:"post_count" example:"12"`
+}
+
+type SwaggerTags struct {
+	TotalCount int          `json:"total_count"`
+	Page       int          `json:"page"`
+	PerPage    int          `json:"per_page"`
+	Tags       []SwaggerTag `json:"tags"`
+}
+
+type SwaggerTagPage struct {
+	SwaggerTag
+	FollowerCount int                  `json:"follower_count" example:"3"`
+	Aliases       []string             `json:"aliases" example:"golang"`
+	Page          int                  `json:"page"`
+	PerPage       int                  `json:"per_page"`
+	Posts         []SwaggerPostSummary `json:"posts"`
+}
+
+type ReadingListForm struct {
+	Name   string `json:"name" validate:"required" example:"Weekend reads"`
+	Public bool   `json:"public" example:"true"`
+}
+
+type ReadingListPostForm struct {
+	PostID int `json:"post_id" validate:"required" example:"1"`
+}
+
+type ReadingListOrderForm struct {
+	PostIDs []int `json:"post_ids" validate:"required" example:"3,1,2"`
+}
+
+type SwaggerReadingList struct {
+	ID        int    `json:"id" example:"1"`
+	UserID    int    `json:"user_id" example:"1"`
+	Name      string `json:"name" example:"Weekend reads"`
+	Default   bool   `json:"default" example:"false"`
+	Public    bool   `json:"public" example:"true"`
+	PostCount int    `json:"post_count" example:"4"`
+}
+
+type SwaggerReadingLists struct {
+	Lists []SwaggerReadingList `json:"lists"`
+}
+
+type SwaggerReadingListPage struct {
+	SwaggerReadingList
+	Posts []SwaggerPostSummary `json:"posts"`
+}
+
+type HighlightForm struct {
+	Start int    `json:"start" example:"12"`
+	End   int    `json:"end" example:"48"`
+	Quote string `json:"quote" validate:"required,max=1000" example:"the passage worth remembering"`
+	Note  string `json:"note" example:"Read again"`
+}
+
+type HighlightNoteForm struct {
+	Note string `json:"note" example:"Read again"`
+}
+
+type SwaggerHighlight struct {
+	ID       int    `json:"id" example:"1"`
+	Start    int    `json:"start" example:"12"`
+	End      int    `json:"end" example:"48"`
+	Quote    string `json:"quote" example:"the passage worth remembering"`
+	Note     string `json:"note" example:"Read again"`
+	Revision int    `json:"revision" example:"2"`
+	Located  bool   `json:"located" example:"true"`
+}
+
+type SwaggerPassage struct {
+	Start int    `json:"start" example:"12"`
+	End   int    `json:"end" example:"48"`
+	Quote string `json:"quote" example:"the passage worth remembering"`
+	Count int    `json:"count" example:"7"`
+}
+
+type SwaggerHighlights struct {
+	Top     []SwaggerPassage   `json:"top"`
+	Mine    []SwaggerHighlight `json:"mine,omitempty"`
+	Paywall bool               `json:"paywall"`
+}
+
+type SeriesForm struct {
+	Title       string `json:"title" validate:"required" example:"Building a blog in Go"`
+	Description string `json:"description" example:"A tutorial in five parts"`
+}
+
+type SeriesPostForm struct {
+	PostID int `json:"post_id" validate:"required" example:"1"`
+}
+
+type SeriesOrderForm struct {
+	PostIDs []int `json:"post_ids" validate:"required" example:"3,1,2"`
+}
+
+type CoAuthorForm struct {
+	UserID int `json:"user_id" validate:"required" example:"2"`
+}
+
+type CoAuthorOrderForm struct {
+	UserIDs []int `json:"user_ids" validate:"required" example:"3,2"`
+}
+
+type SwaggerCoAuthor struct {
+	UserID   int    `json:"user_id" example:"2"`
+	Name     string `json:"name" example:"Someone"`
+	Accepted bool   `json:"accepted" example:"true"`
+}
+
+type SwaggerCoAuthors struct {
+	Authors []SwaggerCoAuthor `json:"authors"`
+}
+
+type SubscriptionForm struct {
+	Tier   string `json:"tier" validate:"required" example:"member"`
+	Period string `json:"period" validate:"required" example:"month"`
+	Token  string `json:"token" validate:"required" example:"tok_visa"`
+}
+
+type SwaggerSubscription struct {
+	Tier             string `json:"tier" example:"member"`
+	Period           string `json:"period" example:"month"`
+	Status           string `json:"status" example:"active"`
+	CurrentPeriodEnd string `json:"current_period_end" example:"2021-06-01T09:00:00Z"`
+	CanceledAt       string `json:"canceled_at" example:"2021-05-20T09:00:00Z"`
+}
+
+type ShareLinkForm struct {
+	AllowComments bool   `json:"allow_comments" example:"true"`
+	ExpiresAt     string `json:"expires_at" example:"2021-05-08T09:00:00Z"`
+}
+
+type ShareCommentForm struct {
+	Name     string `json:"name" validate:"required" example:"Jane"`
+	Body     string `json:"body" validate:"required" example:"The second section drags a little."`
+	ParentID int    `json:"parent_id" example:"1"`
+}
+
+type SwaggerShareLink struct {
+	ID             int    `json:"id" example:"1"`
+	Token          string `json:"token" example:"Jd2K8xq0bS5nXw7Yh1pRk3Lm9Vt4Zc6Ae0Fg2Hi8Uo4"`
+	URL            string `json:"url" example:"/api/v1/shared/Jd2K8xq0bS5nXw7Yh1pRk3Lm9Vt4Zc6Ae0Fg2Hi8Uo4"`
+	AllowComments  bool   `json:"allow_comments" example:"true"`
+	ExpiresAt      string `json:"expires_at" example:"2021-05-08T09:00:00Z"`
+	RevokedAt      string `json:"revoked_at" example:"2021-05-03T09:00:00Z"`
+	CreatedAt      string `json:"created_at" example:"2021-05-01T09:00:00Z"`
+	AccessCount    int    `json:"access_count" example:"4"`
+	LastAccessedAt string `json:"last_accessed_at" example:"2021-05-02T09:00:00Z"`
+}
+
+type SwaggerShareLinks struct {
+	Links []SwaggerShareLink `json:"links"`
+}
+
+type SwaggerShareAccess struct {
+	IP         string `json:"ip" example:"203.0.113.7"`
+	UserAgent  string `json:"user_agent" example:"Mozilla/5.0"`
+	AccessedAt string `json:"accessed_at" example:"2021-05-02T09:00:00Z"`
+}
+
+type SwaggerShareComment struct {
+	ID        int              `json:"id" example:"1"`
+	ParentID  int              `json:"parent_id,omitempty" example:"1"`
+	Name      string           `json:"name" example:"Jane"`
+	Body      string           `json:"body" example:"The second section drags a little."`
+	Mentions  []SwaggerMention `json:"mentions"`
+	CreatedAt string           `json:"created_at" example:"2021-05-02T09:00:00Z"`
+}
+
+type SwaggerMention struct {
+	UserID int    `json:"user_id" example:"2"`
+	URL    string `json:"url" example:"/api/v1/users/2"`
+}
+
+type SwaggerShareLinkLog struct {
+	SwaggerShareLink
+	Accesses []SwaggerShareAccess  `json:"accesses"`
+	Comments []SwaggerShareComment `json:"comments"`
+}
+
+type SwaggerUploadVariant struct {
+	URL         string `json:"url" example:"/api/v1/uploads/9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08-w640.jpg"`
+	Width       int    `json:"width" example:"640"`
+	Height      int    `json:"height" example:"427"`
+	ContentType string `json:"content_type" example:"image/jpeg"`
+	Size        int64  `json:"size" example:"38512"`
+}
+
+type SwaggerUpload struct {
+	ID          int                    `json:"id" example:"1"`
+	URL         string                 `json:"url" example:"/api/v1/uploads/9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.jpg"`
+	ContentType string                 `json:"content_type" example:"image/jpeg"`
+	Size        int64                  `json:"size" example:"148213"`
+	Width       int                    `json:"width" example:"1200"`
+	Height      int                    `json:"height" example:"800"`
+	BlurHash    string                 `json:"blur_hash" example:"LEHV6nWB2yk8pyo0adR*.7kCMdnj"`
+	Variants    []SwaggerUploadVariant `json:"variants"`
+	Srcset      string                 `json:"srcset" example:"/api/v1/uploads/9f86...-w640.jpg 640w, /api/v1/uploads/9f86....jpg 1200w"`
+	CreatedAt   string                 `json:"created_at" example:"2021-05-01T09:00:00Z"`
+}
+
+type SwaggerNotification struct {
+	ID         int    `json:"id" example:"1"`
+	Type       string `json:"type" example:"like"`
+	Message    string `json:"message" example:"Alice and 12 others liked your post"`
+	PostID     *int   `json:"post_id" example:"1"`
+	ActorID    *int   `json:"actor_id" example:"2"`
+	ActorName  string `json:"actor_name" example:"Alice"`
+	ActorCount int    `json:"actor_count" example:"13"`
+	Body       string `json:"body" example:""`
+	Read       bool   `json:"read" example:"false"`
+	CreatedAt  string `json:"created_at" example:"2021-05-01T09:00:00Z"`
+	UpdatedAt  string `json:"updated_at" example:"2021-05-01T12:00:00Z"`
+}
+
+type SwaggerNotifications struct {
+	UnreadCount   int                   `json:"unread_count" example:"3"`
+	NextCursor    string                `json:"next_cursor" example:"MTYxOTg2MDQwMDAwMDAwMDAwMDo0Mg"`
+	Notifications []SwaggerNotification `json:"notifications"`
+}
+
+type SwaggerUnreadCount struct {
+	UnreadCount int `json:"unread_count" example:"0"`
+}
+
+type SwaggerNotificationPreferences struct {
+	Follow    bool `json:"follow" example:"true"`
+	Like      bool `json:"like" example:"false"`
+	Comment   bool `json:"comment" example:"true"`
+	Reply     bool `json:"reply" example:"true"`
+	Mention   bool `json:"mention" example:"true"`
+	Published bool `json:"published" example:"true"`
+}
+
+type SwaggerFollow struct {
+	UserID    int  `json:"user_id" example:"2"`
+	Following bool `json:"following" example:"true"`
+	Followers int  `json:"followers" example:"42"`
+}
+
+type SwaggerBlock struct {
+	UserID   int  `json:"user_id" example:"2"`
+	Blocking bool `json:"blocking" example:"true"`
+}
+
+type SwaggerOrphanedUpload struct {
+	SwaggerUpload
+	UserID int `json:"user_id" example:"1"`
+}
+
+type SwaggerOrphanedBlob struct {
+	Hash   string `json:"hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Widths []int  `json:"widths" example:"320,640"`
+	Size   int64  `json:"size" example:"212431"`
+}
+
+type SwaggerUploadSweep struct {
+	Before  string                  `json:"before" example:"2021-05-01T09:00:00Z"`
+	Uploads []SwaggerOrphanedUpload `json:"uploads"`
+	Blobs   []SwaggerOrphanedBlob   `json:"blobs"`
+	Size    int64                   `json:"size" example:"212431"`
+}
+
+type SwaggerUploads struct {
+	Used    int64           `json:"used" example:"48213"`
+	Quota   int64           `json:"quota" example:"104857600"`
+	Uploads []SwaggerUpload `json:"uploads"`
+}
+
+type SwaggerSeries struct {
+	ID          int                  `json:"id" example:"1"`
+	Author      string               `json:"author" example:"Someone"`
+	Title       string               `json:"title" example:"Building a blog in Go"`
+	Description string               `json:"description" example:"A tutorial in five parts"`
+	Posts       []SwaggerPostSummary `json:"posts"`
+}
+
+type PublicationForm struct {
+	Name        string `json:"name" validate:"required" example:"Go Weekly"`
+	Description string `json:"description" example:"Stories about Go"`
+}
+
+type PublicationMemberForm struct {
+	UserID int    `json:"user_id" validate:"required" example:"2"`
+	Role   string `json:"role" validate:"required" example:"editor"`
+}
+
+type SubmissionForm struct {
+	PostID int `json:"post_id" validate:"required" example:"1"`
+}
+
+type ReviewForm struct {
+	Note string `json:"note" example:"Great read, published as is."`
+}
+
+type SwaggerPublicationMember struct {
+	UserID int    `json:"user_id" example:"2"`
+	Email  string `json:"email" example:"someone@somewhere.com"`
+	Role   string `json:"role" example:"editor"`
+}
+
+type SwaggerPublication struct {
+	ID          int                        `json:"id" example:"1"`
+	Slug        string                     `json:"slug" example:"go-weekly"`
+	Name        string                     `json:"name" example:"Go Weekly"`
+	Description string                     `json:"description" example:"Stories about Go"`
+	Members     []SwaggerPublicationMember `json:"members"`
+	Page        int                        `json:"page"`
+	PerPage     int                        `json:"per_page"`
+	Posts       []SwaggerPostSummary       `json:"posts"`
+}
+
+type SwaggerSubmission struct {
+	ID            int                `json:"id" example:"1"`
+	PublicationID int                `json:"publication_id" example:"1"`
+	Status        string             `json:"status" example:"pending"`
+	Note          string             `json:"note" example:"Please shorten the intro."`
+	SubmittedBy   int                `json:"submitted_by" example:"3"`
+	ReviewedBy    int                `json:"reviewed_by" example:"2"`
+	Post          SwaggerPostSummary `json:"post"`
+}
+
+type SwaggerSubmissions struct {
+	Submissions []SwaggerSubmission `json:"submissions"`
 }
 
 type UserUpdateForm struct {
-	ID             int    `json:"id" example:"1" validate:"required"`
-	Email          string `json:"email" example:"someone@somewhere.com"`
-	Password       string `json:"password" example:"very-hard-password!2"`
-	TokenExpiresIn int64  `json:"token_expires_in" example:"15233324"`
+	ID             int     `json:"id" example:"1" validate:"required"`
+	Email          string  `json:"email" example:"someone@somewhere.com"`
+	Password       string  `json:"password" example:"very-hard-password!2"`
+	TokenExpiresIn int64   `json:"token_expires_in" example:"15233324"`
+	Avatar         *string `json:"avatar" example:"/api/v1/uploads/<hash>.png"`
 }
 
 type UserInsertForm struct {
@@ -44,14 +451,32 @@ type APIError struct {
 	Msg string `json:"message"`
 }
 
+type APIFieldError struct {
+	Msg    string            `json:"message" example:"Invalid filters."`
+	Fields map[string]string `json:"fields"`
+}
+
+type SwaggerPostSummary struct {
+	ID          int      `json:"id" example:"1"`
+	Author      string   `json:"author" example:"Someone"`
+	Authors     []string `json:"authors" example:"Someone,Someone Else"`
+	Title       string   `json:"title" example:"some-title"`
+	Excerpt     string   `json:"excerpt" example:"The first sentences of the post."`
+	Tags        []string `json:"tags" example:"some,tags,here"`
+	Likes       int      `json:"likes" example:"123"`
+	WordCount   int      `json:"word_count" example:"850"`
+	ReadingTime int      `json:"reading_time" example:"4"`
+}
+
 type SwaggerPosts struct {
-	TotalCount int              `json:"total_count"`
-	Posts      []PostUpdateForm `json:"posts"`
+	TotalCount int                  `json:"total_count"`
+	Posts      []SwaggerPostSummary `json:"posts"`
 }
 
 type SwaggerUser struct {
-	ID    int    `json:"id"`
-	Email string `json:"email"`
+	ID     int    `json:"id"`
+	Email  string `json:"email"`
+	Avatar string `json:"avatar,omitempty" example:"/api/v1/uploads/<hash>.png"`
 }
 
 type SwaggerEmail struct {
@@ -65,36 +490,394 @@ func HandleError(c *gin.Context, code int, msg string) {
 	c.JSON(code, &APIError{Msg: msg})
 }
 
-func checkIfQueriesExist(v url.Values) bool {
-	if len(v) > 0 {
-		return true
-	}
-	return false
+// HandleFieldErrors attaches an error response with a message for each invalid field to gin.Context
+func HandleFieldErrors(c *gin.Context, code int, msg string, fields map[string]string) {
+	c.JSON(code, &APIFieldError{Msg: msg, Fields: fields})
 }
 
 func serializeUser(u *models.User) response {
 	return response{
-		"id":    u.ID,
-		"email": u.Email,
+		"id":     u.ID,
+		"email":  u.Email,
+		"avatar": u.Avatar,
 	}
 }
 
 func serializePost(p *models.Post) response {
 	author := strings.Title(strings.ToLower(p.Author.String))
+	s := summarizePost(p)
+	return response{
+		"id":             p.ID,
+		"author":         author,
+		"authors":        bylines(p),
+		"title":          p.Title,
+		"doc":            p.Document,
+		"tags":           p.Tags,
+		"comments":       p.Comments,
+		"likes":          p.Likes,
+		"publish_at":     p.PublishAt,
+		"published_at":   p.PublishedAt,
+		"publication_id": p.PublicationID,
+		"members_only":   p.MembersOnly,
+		"word_count":     s.WordCount,
+		"reading_time":   s.ReadingTime,
+	}
+}
+
+// bylines returns the names of the primary author and the accepted co-authors of a post in order.
+// Co-authors are listed when the post was loaded with them
+func bylines(p *models.Post) []string {
+	names := []string{strings.Title(strings.ToLower(p.Author.String))}
+	if p.R == nil {
+		return names
+	}
+	for _, coAuthor := range p.R.PostAuthors {
+		if coAuthor.AcceptedAt.Valid && coAuthor.R != nil && coAuthor.R.User != nil {
+			names = append(names, strings.Title(strings.ToLower(db.Username(coAuthor.R.User))))
+		}
+	}
+	return names
+}
+
+func serializeCoAuthor(a *models.PostAuthor) response {
+	return response{
+		"user_id":  a.UserID,
+		"name":     strings.Title(strings.ToLower(db.Username(a.R.User))),
+		"accepted": a.AcceptedAt.Valid,
+	}
+}
+
+func serializeSubscription(s *models.Subscription) response {
+	return response{
+		"tier":               s.Tier,
+		"period":             s.Period,
+		"status":             s.Status,
+		"current_period_end": s.CurrentPeriodEnd,
+		"canceled_at":        s.CanceledAt,
+	}
+}
+
+func serializeShareLink(l *models.ShareLink) response {
+	return response{
+		"id":             l.ID,
+		"allow_comments": l.AllowComments,
+		"expires_at":     l.ExpiresAt,
+		"revoked_at":     l.RevokedAt,
+		"created_at":     l.CreatedAt,
+	}
+}
+
+func serializeShareLinkAccess(a *models.ShareLinkAccess) response {
+	return response{
+		"ip":          a.IP,
+		"user_agent":  a.UserAgent,
+		"accessed_at": a.AccessedAt,
+	}
+}
+
+func serializeShareLinkComment(c *models.ShareLinkComment) response {
+	mentions := []response{}
+	if c.R != nil {
+		for _, m := range c.R.CommentCommentMentions {
+			mentions = append(mentions, response{"user_id": m.UserID, "url": db.ProfileURL(m.UserID)})
+		}
+	}
+	serialized := response{
+		"id":         c.ID,
+		"name":       c.Name,
+		"body":       c.Body,
+		"mentions":   mentions,
+		"created_at": c.CreatedAt,
+	}
+	if c.ParentID.Valid {
+		serialized["parent_id"] = c.ParentID.Int
+	}
+	return serialized
+}
+
+func serializeUpload(u *models.Upload) response {
+	variants := []response{}
+	srcset := []string{}
+	if u.R != nil {
+		for _, v := range u.R.UploadVariants {
+			url := variantURL(u, v)
+			variants = append(variants, response{
+				"url":          url,
+				"width":        v.Width,
+				"height":       v.Height,
+				"content_type": v.ContentType,
+				"size":         v.Size,
+			})
+			srcset = append(srcset, fmt.Sprintf("%s %dw", url, v.Width))
+		}
+	}
+	srcset = append(srcset, fmt.Sprintf("%s %dw", uploadURL(u), u.Width))
+
+	return response{
+		"id":           u.ID,
+		"url":          uploadURL(u),
+		"content_type": u.ContentType,
+		"size":         u.Size,
+		"width":        u.Width,
+		"height":       u.Height,
+		"blur_hash":    u.BlurHash,
+		"variants":     variants,
+		"srcset":       strings.Join(srcset, ", "),
+		"created_at":   u.CreatedAt,
+	}
+}
+
+func serializeNotification(n *models.Notification) response {
+	return response{
+		"id":          n.ID,
+		"type":        n.Type,
+		"message":     notificationMessage(n),
+		"post_id":     n.PostID,
+		"actor_id":    n.ActorID,
+		"actor_name":  strings.Title(strings.ToLower(n.ActorName)),
+		"actor_count": n.ActorCount,
+		"body":        n.Body,
+		"read":        n.ReadAt.Valid,
+		"created_at":  n.CreatedAt,
+		"updated_at":  n.UpdatedAt,
+	}
+}
+
+// serializePostSummary serializes a post for listings, with an excerpt in place of the document.
+// The excerpt of a members-only post is taken from the preview shown behind the paywall
+func serializePostSummary(p *models.Post) response {
+	serialized := serializePost(p)
+	delete(serialized, "doc")
+	if p.MembersOnly {
+		serialized["excerpt"] = summary.Excerpt(blocks.FromMarkdown(db.PreviewDocument(p.Document.String)), summary.ExcerptLength)
+	} else {
+		serialized["excerpt"] = summarizePost(p).Excerpt
+	}
+	return serialized
+}
+
+// summarizePost returns the stored summary of a post.
+// Posts saved before summaries were introduced are summarized on the fly
+func summarizePost(p *models.Post) *summary.Summary {
+	if !p.Excerpt.Valid {
+		return summary.Summarize(blocks.FromMarkdown(p.Document.String))
+	}
+	return &summary.Summary{
+		WordCount:   p.WordCount.Int,
+		ReadingTime: p.ReadingTime.Int,
+		Excerpt:     p.Excerpt.String,
+	}
+}
+
+// serializePostInFormat serializes a post with its document as markdown,
+// rendered HTML with a table of contents, both, or a block tree
+func serializePostInFormat(p *models.Post, format string) response {
+	serialized := serializePost(p)
+	if format == formatMarkdown {
+		return serialized
+	}
+
+	if format == formatBlocks {
+		// Posts saved before blocks were introduced are converted on the fly
+		if p.Blocks.Valid {
+			serialized["blocks"] = p.Blocks
+		} else {
+			serialized["blocks"] = blocks.FromMarkdown(p.Document.String)
+		}
+		delete(serialized, "doc")
+		return serialized
+	}
+
+	// Posts saved before rendering was introduced have no cached HTML
+	if p.DocumentHTML.Valid {
+		serialized["html"] = p.DocumentHTML
+		serialized["toc"] = p.TableOfContents
+	} else {
+		rendered := markdown.Render(p.Document.String)
+		serialized["html"] = rendered.HTML
+		serialized["toc"] = rendered.TOC
+	}
+
+	if format == formatHTML {
+		delete(serialized, "doc")
+	}
+	return serialized
+}
+
+func serializeSearchResult(r *db.SearchResult) response {
+	serialized := serializePostSummary(r.Post)
+	serialized["rank"] = r.Rank
+	serialized["snippet"] = r.Snippet
+	return serialized
+}
+
+func serializeTrendingPost(t *db.TrendingPost) response {
+	serialized := serializePostSummary(t.Post)
+	serialized["score"] = t.Score
+	serialized["rank"] = t.Rank
+	return serialized
+}
+
+func serializeReadingList(l *models.ReadingList, postCount int64) response {
+	return response{
+		"id":         l.ID,
+		"user_id":    l.UserID,
+		"name":       l.Name,
+		"default":    l.IsDefault,
+		"public":     l.IsPublic,
+		"post_count": postCount,
+	}
+}
+
+func serializeHighlight(h *db.LocatedHighlight) response {
+	serialized := response{
+		"id":       h.Highlight.ID,
+		"start":    nil,
+		"end":      nil,
+		"quote":    h.Highlight.Quote,
+		"note":     h.Highlight.Note,
+		"revision": h.Highlight.Revision,
+		"located":  h.Located,
+	}
+	if h.Located {
+		serialized["start"] = h.Range.Start
+		serialized["end"] = h.Range.End
+	}
+	return serialized
+}
+
+func serializePassage(p *db.Passage) response {
+	return response{
+		"start": p.Range.Start,
+		"end":   p.Range.End,
+		"quote": p.Quote,
+		"count": p.Count,
+	}
+}
+
+func serializeSeries(s *models.Series) response {
+	return response{
+		"id":          s.ID,
+		"author":      strings.Title(strings.ToLower(s.Author)),
+		"title":       s.Title,
+		"description": s.Description,
+	}
+}
+
+// serializeSeriesPart serializes the navigation of a post within its series
+func serializeSeriesPart(p *db.SeriesPart) response {
+	link := func(post *models.Post) response {
+		if post == nil {
+			return nil
+		}
+		return response{"id": post.ID, "title": post.Title}
+	}
+	return response{
+		"id":       p.Series.ID,
+		"title":    p.Series.Title,
+		"part":     p.Part,
+		"parts":    p.Parts,
+		"previous": link(p.Previous),
+		"next":     link(p.Next),
+	}
+}
+
+func serializePublication(p *models.Publication) response {
 	return response{
-		"id":       p.ID,
-		"author":   author,
-		"doc":      p.Document,
-		"tags":     p.Tags,
-		"comments": p.Comments,
-		"likes":    p.Likes,
+		"id":          p.ID,
+		"slug":        p.Slug,
+		"name":        p.Name,
+		"description": p.Description,
+	}
+}
+
+func serializePublicationMember(m *db.PublicationMember) response {
+	return response{
+		"user_id": m.UserID,
+		"email":   m.Email,
+		"role":    m.Role,
+	}
+}
+
+func serializeSubmission(s *models.Submission) response {
+	serialized := response{
+		"id":             s.ID,
+		"publication_id": s.PublicationID,
+		"status":         s.Status,
+		"note":           s.Note,
+		"submitted_by":   s.SubmittedBy,
+		"reviewed_by":    s.ReviewedBy,
+	}
+	if s.R != nil && s.R.Post != nil {
+		serialized["post"] = serializePostSummary(s.R.Post)
+	}
+	return serialized
+}
+
+func serializeSuggestion(s *db.Suggestion) response {
+	serialized := response{
+		"type":  s.Type,
+		"text":  s.Text,
+		"score": s.Score,
+	}
+	if s.PostID.Valid {
+		serialized["post_id"] = s.PostID.Int
+	}
+	return serialized
+}
+
+func serializeTag(t *models.Tag) response {
+	return response{
+		"slug": t.Slug,
+		"name": t.Name,
+	}
+}
+
+func serializeTags(tags []*models.Tag) response {
+	serialized := make([]response, len(tags))
+	for i, t := range tags {
+		serialized[i] = serializeTag(t)
+	}
+
+	return response{
+		"total_count": len(tags),
+		"tags":        serialized,
+	}
+}
+
+func serializeRevision(r *models.PostRevision) response {
+	return response{
+		"post_id":    r.PostID,
+		"revision":   r.Revision,
+		"title":      r.Title,
+		"doc":        r.Document,
+		"tags":       r.Tags,
+		"editor":     r.Editor,
+		"created_at": r.CreatedAt,
+	}
+}
+
+func serializeRevisions(revisions []*models.PostRevision) response {
+	serialized := make([]response, len(revisions))
+	for i, r := range revisions {
+		serialized[i] = serializeRevision(r)
+	}
+
+	return response{
+		"total_count": len(revisions),
+		"revisions":   serialized,
 	}
 }
 
 func serializePosts(posts []*models.Post) response {
+	serialized := make([]response, len(posts))
+	for i, p := range posts {
+		serialized[i] = serializePostSummary(p)
+	}
+
 	return response{
 		"total_count": len(posts),
-		"posts":       posts,
+		"posts":       serialized,
 	}
 }
 
@@ -113,6 +896,10 @@ func validateStruct(c interface{}) error {
 	return nil
 }
 
+func checkIfFormatIsValid(format string) bool {
+	return format == formatMarkdown || format == formatHTML || format == formatBoth || format == formatBlocks
+}
+
 func checkIfUserIsAuthor(c *gin.Context, author string) bool {
 	username, exists := c.Get("username")
 	if !exists {
@@ -121,6 +908,126 @@ func checkIfUserIsAuthor(c *gin.Context, author string) bool {
 	return username == author
 }
 
+// checkIfUserCanEditPost reports whether the current user wrote or co-authors a post
+// or is an editor of the publication it belongs to
+func checkIfUserCanEditPost(c *gin.Context, pool *sql.DB, post *models.Post) bool {
+	if checkIfUserIsAuthor(c, post.Author.String) {
+		return true
+	}
+
+	user, err := getCurrentUser(c, pool)
+	if err != nil {
+		return false
+	}
+	canEdit, err := db.CanEditPost(c, pool, post, user.ID)
+	return err == nil && canEdit
+}
+
+// findVisiblePost returns a post by its ID when it has gone live or the current user may edit it.
+// Drafts and scheduled posts of other users are not found, so they are not exposed
+func findVisiblePost(c *gin.Context, pool *sql.DB, id int64) (*models.Post, error) {
+	post, err := db.GetPostByID(c, pool, id)
+	if err != nil {
+		return nil, err
+	}
+	if !post.PublishedAt.Valid && !checkIfUserCanEditPost(c, pool, post) {
+		return nil, sql.ErrNoRows
+	}
+	return post, nil
+}
+
+// checkIfUserCanRead reports whether the current user reads a post in full. Readers
+// without a subscription use up their monthly allowance of members-only posts, in which
+// case the meter is returned as well
+func checkIfUserCanRead(c *gin.Context, pool *sql.DB, post *models.Post, allowance int) (bool, *db.MeteredRead, error) {
+	if !post.MembersOnly {
+		return true, nil, nil
+	}
+
+	user, err := getCurrentUser(c, pool)
+	if err != nil {
+		// Anonymous readers are not metered
+		return false, nil, nil
+	}
+	if checkIfUserCanEditPost(c, pool, post) {
+		return true, nil, nil
+	}
+
+	now := time.Now()
+	subscribed, err := db.HasValidSubscription(c, pool, user.ID, now)
+	if err != nil || subscribed {
+		return subscribed, nil, err
+	}
+
+	meter, err := db.ReadMeteredPost(c, pool, user.ID, post.ID, allowance, now)
+	if err != nil {
+		return false, nil, err
+	}
+	return meter.Allowed, meter, nil
+}
+
+// checkIfUserCanReadInFull reports whether the current user reads a post in full,
+// handling the error when the subscription cannot be checked
+func checkIfUserCanReadInFull(c *gin.Context, pool *sql.DB, post *models.Post, env *config.EnvVars) (bool, bool) {
+	entitled, _, err := checkIfUserCanRead(c, pool, post, env.FreeArticlesPerMonth)
+	if err != nil {
+		HandleError(c, http.StatusInternalServerError, "Failed to retrieve subscription from DB.")
+		return false, false
+	}
+	return entitled, true
+}
+
+// previewPost returns a copy of a post keeping the first blocks of its document
+func previewPost(p *models.Post) *models.Post {
+	preview := *p
+	preview.Document = null.StringFrom(db.PreviewDocument(p.Document.String))
+	// Cleared so that the other formats are derived from the preview
+	preview.Blocks = null.JSON{}
+	preview.DocumentHTML = null.String{}
+	preview.TableOfContents = null.JSON{}
+	return &preview
+}
+
+// getCurrentUser returns the user verified by VerifyUser
+func getCurrentUser(c *gin.Context, pool *sql.DB) (*models.User, error) {
+	email, exists := c.Get("email")
+	if !exists {
+		return nil, errors.New("Email not found.")
+	}
+	return db.GetUserByEmail(c, pool, email.(string))
+}
+
+// markBookmarks sets on serialized posts whether the current user saved them to a reading list.
+// Posts are left unmarked for anonymous requests
+func markBookmarks(c *gin.Context, pool *sql.DB, posts ...response) error {
+	user, err := getCurrentUser(c, pool)
+	if err != nil {
+		return nil
+	}
+
+	ids := make([]int, len(posts))
+	for i, p := range posts {
+		ids[i] = p["id"].(int)
+	}
+	bookmarked, err := db.GetBookmarkedPostIDs(c, pool, user.ID, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range posts {
+		p["bookmarked"] = bookmarked[p["id"].(int)]
+	}
+	return nil
+}
+
+// optionalText returns text, or null when it is blank
+func optionalText(text string) null.String {
+	if strings.TrimSpace(text) == "" {
+		return null.String{}
+	}
+	return null.StringFrom(text)
+}
+
 func convertToInt(id string) int64 {
 	idInt, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
@@ -129,14 +1036,67 @@ func convertToInt(id string) int64 {
 	return idInt
 }
 
-func bindFormToPost(f *PostInsertForm, author string) *db.Post {
-	return &db.Post{
-		Author:   strings.ToLower(author),
-		Doc:      f.Doc,
-		Comments: f.Comments,
-		Tags:     strings.Split(f.Tags, ","),
-		Likes:    int(f.Likes),
+// parseTags splits comma separated tags and normalizes them
+func parseTags(tags string) ([]string, error) {
+	return db.NormalizeTags(strings.Split(tags, ","))
+}
+
+// parsePagination reads the page and per_page queries,
+// defaulting to the first page of defaultPerPage results
+func parsePagination(c *gin.Context) (int, int, error) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		return 0, 0, errors.New("Invalid page.")
+	}
+
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", strconv.Itoa(defaultPerPage)))
+	if err != nil || perPage < 1 || perPage > maxPerPage {
+		return 0, 0, errors.New("Invalid page size.")
 	}
+	return page, perPage, nil
+}
+
+// parsePublishAt parses an RFC3339 publish time, which must lie in the future.
+// An empty string yields the zero time, meaning the post is published right away.
+func parsePublishAt(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	publishAt, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !publishAt.After(time.Now()) {
+		return time.Time{}, errors.New("Publish time has passed.")
+	}
+	return publishAt, nil
+}
+
+// parseBlocks parses and validates a block document.
+// An absent document yields nil, meaning the markdown is used instead.
+func parseBlocks(raw json.RawMessage) (*blocks.Document, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	return blocks.Parse(raw)
+}
+
+func bindFormToPost(f *PostInsertForm, author string) (*db.Post, error) {
+	tags, err := parseTags(f.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &db.Post{
+		Author:      strings.ToLower(author),
+		Title:       f.Title,
+		Doc:         f.Doc,
+		Comments:    f.Comments,
+		Tags:        tags,
+		MembersOnly: null.BoolFrom(f.MembersOnly),
+	}, nil
 }
 
 func bindUpdateFormToPost(f *PostUpdateForm, author string) (*db.Post, error) {
@@ -146,7 +1106,7 @@ func bindUpdateFormToPost(f *PostUpdateForm, author string) (*db.Post, error) {
 		return nil, errors.New("ID required.")
 	}
 
-	if f.Comments == "" && f.Doc == "" && f.Likes < 0 && f.Tags == "" {
+	if f.Comments == "" && f.Doc == "" && len(f.Blocks) == 0 && f.Title == "" && f.Tags == "" && f.MembersOnly == nil {
 		return nil, errors.New("No new data.")
 	}
 
@@ -154,14 +1114,19 @@ func bindUpdateFormToPost(f *PostUpdateForm, author string) (*db.Post, error) {
 		post.Comments = f.Comments
 	}
 	if f.Doc != "" {
-		post.Comments = f.Comments
+		post.Doc = f.Doc
 	}
-	if f.Likes >= 0 {
-		post.Likes = int(f.Likes)
+	if f.Title != "" {
+		post.Title = f.Title
 	}
 	if f.Tags != "" {
-		post.Tags = strings.Split(f.Tags, ",")
+		tags, err := parseTags(f.Tags)
+		if err != nil {
+			return nil, err
+		}
+		post.Tags = tags
 	}
+	post.MembersOnly = null.BoolFromPtr(f.MembersOnly)
 	post.Author = author
 
 	return &post, nil
@@ -183,7 +1148,7 @@ func bindUpdateFormToUser(b *UserUpdateForm) (*db.User, error) {
 		return nil, errors.New("ID required.")
 	}
 
-	if b.Email == "" && b.Password == "" && b.TokenExpiresIn < 0 {
+	if b.Email == "" && b.Password == "" && b.TokenExpiresIn < 0 && b.Avatar == nil {
 		return nil, errors.New("No new data.")
 	}
 
@@ -205,5 +1170,7 @@ func bindUpdateFormToUser(b *UserUpdateForm) (*db.User, error) {
 		user.TokenExpiresIn = b.TokenExpiresIn
 	}
 
+	user.Avatar = b.Avatar
+
 	return &user, nil
 }
@@ -0,0 +1,210 @@
+package blocks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Version is the version of the block document format written by the server
+const Version = 1
+
+// Block types understood by the server
+const (
+	Paragraph = "paragraph"
+	Heading   = "heading"
+	Image     = "image"
+	Quote     = "quote"
+	Code      = "code"
+	Embed     = "embed"
+)
+
+// maxDepth limits how deeply quotes can be nested
+const maxDepth = 8
+
+var languagePattern = regexp.MustCompile(`^[\w+#.-]*$`)
+
+// Document is a versioned tree of blocks produced by the editor
+type Document struct {
+	Version int      `json:"version"`
+	Blocks  []*Block `json:"blocks"`
+}
+
+// Block is a single node of a document.
+// Which fields are allowed depends on the type of the block
+type Block struct {
+	Type     string   `json:"type"`
+	Text     string   `json:"text,omitempty"`
+	Level    int      `json:"level,omitempty"`
+	Language string   `json:"language,omitempty"`
+	URL      string   `json:"url,omitempty"`
+	Alt      string   `json:"alt,omitempty"`
+	Caption  string   `json:"caption,omitempty"`
+	Children []*Block `json:"children,omitempty"`
+}
+
+// ValidationError describes why a field of a document is invalid
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Parse decodes a document and validates it against the block schema
+func Parse(data []byte) (*Document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var doc Document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, &ValidationError{"blocks", err.Error()}
+	}
+	if decoder.More() {
+		return nil, &ValidationError{"blocks", "unexpected data after document"}
+	}
+
+	if err := Validate(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Validate checks the version of the document and every block in it
+func Validate(doc *Document) error {
+	if doc.Version != Version {
+		return &ValidationError{"version", fmt.Sprintf("unsupported version %d", doc.Version)}
+	}
+	if len(doc.Blocks) == 0 {
+		return &ValidationError{"blocks", "at least one block is required"}
+	}
+	return validateBlocks(doc.Blocks, "blocks", 0)
+}
+
+func validateBlocks(blocks []*Block, path string, depth int) error {
+	for i, b := range blocks {
+		field := fmt.Sprintf("%s[%d]", path, i)
+		if b == nil {
+			return &ValidationError{field, "block is empty"}
+		}
+		if err := validateBlock(b, field, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateBlock(b *Block, field string, depth int) error {
+	allowed := map[string]bool{}
+	switch b.Type {
+	case Paragraph:
+		allowed["text"] = true
+		if strings.TrimSpace(b.Text) == "" {
+			return &ValidationError{field + ".text", "is required"}
+		}
+		// Text that reads as other blocks, such as a blank line followed by a heading,
+		// would split the paragraph when the document is written as markdown
+		if parsed := FromMarkdown(b.Text).Blocks; len(parsed) != 1 || parsed[0].Type != Paragraph {
+			return &ValidationError{field + ".text", "must be a single paragraph"}
+		}
+	case Heading:
+		allowed["text"], allowed["level"] = true, true
+		if b.Level < 1 || b.Level > 6 {
+			return &ValidationError{field + ".level", "must be between 1 and 6"}
+		}
+		if strings.TrimSpace(b.Text) == "" {
+			return &ValidationError{field + ".text", "is required"}
+		}
+		if strings.Contains(b.Text, "\n") {
+			return &ValidationError{field + ".text", "must be a single line"}
+		}
+	case Code:
+		allowed["text"], allowed["language"] = true, true
+		if !languagePattern.MatchString(b.Language) {
+			return &ValidationError{field + ".language", "is not a valid language name"}
+		}
+	case Image:
+		allowed["url"], allowed["alt"], allowed["caption"] = true, true, true
+		if err := validateURL(b.URL, field+".url"); err != nil {
+			return err
+		}
+	case Embed:
+		allowed["url"], allowed["caption"] = true, true
+		if err := validateURL(b.URL, field+".url"); err != nil {
+			return err
+		}
+	case Quote:
+		allowed["children"] = true
+		if depth+1 >= maxDepth {
+			return &ValidationError{field + ".children", "quotes are nested too deeply"}
+		}
+		if len(b.Children) == 0 {
+			return &ValidationError{field + ".children", "is required"}
+		}
+		if err := validateBlocks(b.Children, field+".children", depth+1); err != nil {
+			return err
+		}
+	default:
+		return &ValidationError{field + ".type", fmt.Sprintf("unknown block type %q", b.Type)}
+	}
+
+	for _, name := range setFields(b) {
+		if !allowed[name] {
+			return &ValidationError{field + "." + name, fmt.Sprintf("not allowed on %s blocks", b.Type)}
+		}
+	}
+	return nil
+}
+
+// setFields returns the names of the fields that are set on the block
+func setFields(b *Block) []string {
+	var fields []string
+	if b.Text != "" {
+		fields = append(fields, "text")
+	}
+	if b.Level != 0 {
+		fields = append(fields, "level")
+	}
+	if b.Language != "" {
+		fields = append(fields, "language")
+	}
+	if b.URL != "" {
+		fields = append(fields, "url")
+	}
+	if b.Alt != "" {
+		fields = append(fields, "alt")
+	}
+	if b.Caption != "" {
+		fields = append(fields, "caption")
+	}
+	if len(b.Children) > 0 {
+		fields = append(fields, "children")
+	}
+	return fields
+}
+
+func validateURL(rawURL, field string) error {
+	if rawURL == "" {
+		return &ValidationError{field, "is required"}
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return &ValidationError{field, "must be an http or https URL or a path starting with /"}
+	}
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return nil
+	}
+	// Root-relative paths, such as the URLs of uploads, stay on this site. Browsers read
+	// "//" and "/\" as the start of another host, so those are not paths
+	if u.Scheme == "" && u.Host == "" && strings.HasPrefix(rawURL, "/") &&
+		!strings.HasPrefix(rawURL, "//") && !strings.HasPrefix(rawURL, "/\\") {
+		return nil
+	}
+	return &ValidationError{field, "must be an http or https URL or a path starting with /"}
+}
@@ -0,0 +1,201 @@
+package blocks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// ToMarkdown writes a document as CommonMark
+func ToMarkdown(doc *Document) string {
+	return writeBlocks(doc.Blocks)
+}
+
+func writeBlocks(blocks []*Block) string {
+	parts := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		parts = append(parts, writeBlock(b))
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+func writeBlock(b *Block) string {
+	switch b.Type {
+	case Heading:
+		return strings.Repeat("#", b.Level) + " " + b.Text
+	case Code:
+		fence := codeFence(b.Text)
+		return fence + b.Language + "\n" + b.Text + "\n" + fence
+	case Image:
+		return "!" + writeLink(b.Alt, b.URL, b.Caption)
+	case Embed:
+		// A link whose text is its URL, as autolinks only take absolute URLs
+		return writeLink(b.URL, b.URL, b.Caption)
+	case Quote:
+		lines := strings.Split(writeBlocks(b.Children), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	default:
+		return b.Text
+	}
+}
+
+// writeLink writes a link with its destination in angle brackets so that it may hold spaces
+func writeLink(text, url, title string) string {
+	if title == "" {
+		return fmt.Sprintf("[%s](<%s>)", escapeText(text), escapeText(url))
+	}
+	return fmt.Sprintf("[%s](<%s> \"%s\")", escapeText(text), escapeText(url), escapeText(title))
+}
+
+// codeFence returns a backtick fence longer than any run of backticks in the code
+func codeFence(code string) string {
+	longest, run := 0, 0
+	for _, r := range code {
+		if r == '`' {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+	if longest < 3 {
+		return "```"
+	}
+	return strings.Repeat("`", longest+1)
+}
+
+// markdownEscaper backslash-escapes the characters that start markup within links, so that
+// their text and destination are read back as written
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `"`, `\"`,
+	"`", "\\`", `*`, `\*`, `_`, `\_`, `&`, `\&`,
+)
+
+func escapeText(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// unescapeText reverses escapeText
+func unescapeText(s []byte) string {
+	return string(util.UnescapePunctuations(s))
+}
+
+// FromMarkdown builds a document from CommonMark.
+// Markdown without a matching block type, such as lists, is kept in paragraphs as written
+func FromMarkdown(source string) *Document {
+	src := []byte(source)
+	root := parser.NewParser(
+		parser.WithBlockParsers(parser.DefaultBlockParsers()...),
+		parser.WithInlineParsers(parser.DefaultInlineParsers()...),
+		parser.WithParagraphTransformers(parser.DefaultParagraphTransformers()...),
+	).Parse(text.NewReader(src))
+
+	return &Document{
+		Version: Version,
+		Blocks:  readBlocks(root, src),
+	}
+}
+
+func readBlocks(parent ast.Node, src []byte) []*Block {
+	blocks := []*Block{}
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		if b := readBlock(n, src); b != nil {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+func readBlock(n ast.Node, src []byte) *Block {
+	switch node := n.(type) {
+	case *ast.Heading:
+		return &Block{Type: Heading, Level: node.Level, Text: strings.TrimSpace(lines(node, src))}
+	case *ast.FencedCodeBlock:
+		return &Block{Type: Code, Language: string(node.Language(src)), Text: strings.TrimSuffix(lines(node, src), "\n")}
+	case *ast.CodeBlock:
+		return &Block{Type: Code, Text: strings.TrimSuffix(lines(node, src), "\n")}
+	case *ast.Blockquote:
+		children := readBlocks(node, src)
+		if len(children) == 0 {
+			return nil
+		}
+		return &Block{Type: Quote, Children: children}
+	case *ast.Paragraph:
+		if node.ChildCount() == 1 {
+			switch child := node.FirstChild().(type) {
+			case *ast.Image:
+				return &Block{
+					Type:    Image,
+					URL:     unescapeText(child.Destination),
+					Alt:     unescapeText(child.Text(src)),
+					Caption: unescapeText(child.Title),
+				}
+			case *ast.Link:
+				if url := unescapeText(child.Destination); unescapeText(child.Text(src)) == url {
+					return &Block{Type: Embed, URL: url, Caption: unescapeText(child.Title)}
+				}
+			case *ast.AutoLink:
+				if child.AutoLinkType == ast.AutoLinkURL {
+					return &Block{Type: Embed, URL: string(child.URL(src))}
+				}
+			}
+		}
+		return &Block{Type: Paragraph, Text: strings.TrimSpace(lines(node, src))}
+	default:
+		raw := strings.TrimSpace(rawSource(n, src))
+		if raw == "" {
+			return nil
+		}
+		return &Block{Type: Paragraph, Text: raw}
+	}
+}
+
+// lines joins the source lines of a leaf block
+func lines(n ast.Node, src []byte) string {
+	var b strings.Builder
+	segments := n.Lines()
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		b.Write(segment.Value(src))
+	}
+	return b.String()
+}
+
+// rawSource returns the full source lines spanned by a node and its descendants
+func rawSource(n ast.Node, src []byte) string {
+	start, stop := len(src), 0
+	ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || c.Type() != ast.TypeBlock {
+			return ast.WalkContinue, nil
+		}
+		segments := c.Lines()
+		for i := 0; i < segments.Len(); i++ {
+			segment := segments.At(i)
+			if segment.Start < start {
+				start = segment.Start
+			}
+			if segment.Stop > stop {
+				stop = segment.Stop
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	if start >= stop {
+		return ""
+	}
+
+	// Include list markers and indentation in front of the first line
+	for start > 0 && src[start-1] != '\n' {
+		start--
+	}
+	return string(src[start:stop])
+}
@@ -0,0 +1,37 @@
+package blocks
+
+import (
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
+)
+
+// PlainText returns the readable text of a document for excerpts and search.
+// Inline markdown is stripped and images contribute their alt text and caption
+func PlainText(doc *Document) string {
+	return strings.Join(plainTexts(doc.Blocks), "\n")
+}
+
+func plainTexts(blocks []*Block) []string {
+	var texts []string
+	for _, b := range blocks {
+		var t string
+		switch b.Type {
+		case Quote:
+			texts = append(texts, plainTexts(b.Children)...)
+			continue
+		case Code:
+			t = b.Text
+		case Image:
+			t = strings.TrimSpace(b.Alt + " " + b.Caption)
+		case Embed:
+			t = b.Caption
+		default:
+			t = markdown.PlainText(b.Text)
+		}
+		if t != "" {
+			texts = append(texts, t)
+		}
+	}
+	return texts
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"bufio"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -10,7 +11,24 @@ import (
 
 // EnvVars holds environment variables necessary for the server
 type EnvVars struct {
-	JWTSecret string
+	JWTSecret            string
+	TrashRetentionDays   int
+	PaymentProvider      string
+	FreeArticlesPerMonth int
+	StorageBackend       string
+	StorageDir           string
+	S3Endpoint           string
+	S3Region             string
+	S3Bucket             string
+	S3AccessKey          string
+	S3SecretKey          string
+	UploadMaxBytes       int
+	UploadQuotaBytes     int
+	ImageVariantWidths   []int
+	UploadGraceHours     int
+	StreamHeartbeatSecs  int
+	StreamReplaySize     int
+	StreamQueueSize      int
 }
 
 // InitLogger returns a formatted logger
@@ -53,7 +71,53 @@ func ReadVariablesFromFile(filename string) {
 // LoadEnvVars load environment variables necessary for the server
 func LoadEnvVars() *EnvVars {
 	return &EnvVars{
-		JWTSecret: os.Getenv("JWT_SECRET"),
+		JWTSecret:            os.Getenv("JWT_SECRET"),
+		TrashRetentionDays:   getIntEnv("TRASH_RETENTION_DAYS", 30),
+		PaymentProvider:      getStringEnv("PAYMENT_PROVIDER", "fake"),
+		FreeArticlesPerMonth: getIntEnv("FREE_ARTICLES_PER_MONTH", 3),
+		StorageBackend:       getStringEnv("STORAGE_BACKEND", "local"),
+		StorageDir:           getStringEnv("STORAGE_DIR", "uploads"),
+		S3Endpoint:           os.Getenv("S3_ENDPOINT"),
+		S3Region:             os.Getenv("S3_REGION"),
+		S3Bucket:             os.Getenv("S3_BUCKET"),
+		S3AccessKey:          os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:          os.Getenv("S3_SECRET_KEY"),
+		UploadMaxBytes:       getIntEnv("UPLOAD_MAX_BYTES", 10<<20),
+		UploadQuotaBytes:     getIntEnv("UPLOAD_QUOTA_BYTES", 100<<20),
+		ImageVariantWidths:   getIntListEnv("IMAGE_VARIANT_WIDTHS", []int{320, 640, 1024, 1600}),
+		UploadGraceHours:     getIntEnv("UPLOAD_GRACE_HOURS", 24),
+		StreamHeartbeatSecs:  getIntEnv("STREAM_HEARTBEAT_SECS", 15),
+		StreamReplaySize:     getIntEnv("STREAM_REPLAY_SIZE", 256),
+		StreamQueueSize:      getIntEnv("STREAM_QUEUE_SIZE", 64),
 	}
 
 }
+
+func getIntEnv(n string, dVal int) int {
+	val, err := strconv.Atoi(strings.TrimSpace(os.Getenv(n)))
+	if err != nil {
+		return dVal
+	}
+	return val
+}
+
+func getStringEnv(n string, dVal string) string {
+	val := strings.TrimSpace(os.Getenv(n))
+	if val == "" {
+		return dVal
+	}
+	return val
+}
+
+func getIntListEnv(n string, dVal []int) []int {
+	fields := strings.Split(os.Getenv(n), ",")
+	vals := make([]int, 0, len(fields))
+	for _, f := range fields {
+		val, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil {
+			return dVal
+		}
+		vals = append(vals, val)
+	}
+	return vals
+}
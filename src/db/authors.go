@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// ErrAlreadyAuthor is returned when inviting the primary author or a co-author of a post again
+var ErrAlreadyAuthor = errors.New("User is already an author of the post.")
+
+// withCoAuthors loads the accepted co-authors of posts in byline order along with their users
+var withCoAuthors = []qm.QueryMod{
+	qm.Load(models.PostRels.PostAuthors, qm.Where("accepted_at IS NOT NULL"), qm.OrderBy("position")),
+	qm.Load(models.PostRels.PostAuthors + "." + models.PostAuthorRels.User),
+}
+
+// withCoAuthorsOf loads the accepted co-authors of the posts loaded through a relationship,
+// like withCoAuthors does for posts
+func withCoAuthorsOf(rel string) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Load(rel+"."+models.PostRels.PostAuthors, qm.Where("accepted_at IS NOT NULL"), qm.OrderBy("position")),
+		qm.Load(rel + "." + models.PostRels.PostAuthors + "." + models.PostAuthorRels.User),
+	}
+}
+
+// Username returns the name a user writes under, the local part of their email
+func Username(user *models.User) string {
+	return strings.Split(user.Email.String, "@")[0]
+}
+
+// InviteCoAuthor invites a user to co-author a post. The invitation is placed
+// after the existing co-authors and counts once the user accepts it
+func InviteCoAuthor(ctx context.Context, db *sql.DB, post *models.Post, user *models.User) error {
+	if Username(user) == post.Author.String {
+		return ErrAlreadyAuthor
+	}
+
+	exists, err := models.PostAuthorExists(ctx, db, post.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrAlreadyAuthor
+	}
+
+	_, err = queries.Raw(`
+		INSERT INTO post_authors (post_id, user_id, position)
+		SELECT $1, $2, coalesce(max(position) + 1, 0) FROM post_authors WHERE post_id = $1`,
+		post.ID, user.ID).ExecContext(ctx, db)
+	return err
+}
+
+// AcceptCoAuthorship accepts the pending invitation of a user to co-author a post
+func AcceptCoAuthorship(ctx context.Context, db *sql.DB, postID int, userID int, now time.Time) error {
+	updated, err := models.PostAuthors(
+		qm.Where("post_id = ? AND user_id = ? AND accepted_at IS NULL", postID, userID),
+	).UpdateAll(ctx, db, models.M{"accepted_at": now})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RemoveCoAuthor removes a co-author or a pending invitation from a post
+func RemoveCoAuthor(ctx context.Context, db *sql.DB, postID int, userID int) error {
+	deleted, err := models.PostAuthors(qm.Where("post_id = ? AND user_id = ?", postID, userID)).DeleteAll(ctx, db)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GetCoAuthors returns the co-authors of a post in byline order, pending invitations included
+func GetCoAuthors(ctx context.Context, db *sql.DB, postID int) (models.PostAuthorSlice, error) {
+	return models.PostAuthors(
+		qm.Where("post_id = ?", postID),
+		qm.OrderBy("position"),
+		qm.Load(models.PostAuthorRels.User),
+	).All(ctx, db)
+}
+
+// ReorderCoAuthors puts the co-authors of a post in the given order,
+// which must contain every co-author and invited user exactly once
+func ReorderCoAuthors(ctx context.Context, db *sql.DB, postID int, userIDs []int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	entries, err := models.PostAuthors(qm.Where("post_id = ?", postID), qm.For("UPDATE")).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[int]int, len(userIDs))
+	for i, id := range userIDs {
+		positions[id] = i
+	}
+	if len(positions) != len(userIDs) || len(positions) != len(entries) {
+		return ErrInvalidOrder
+	}
+
+	for _, entry := range entries {
+		position, ok := positions[entry.UserID]
+		if !ok {
+			return ErrInvalidOrder
+		}
+		entry.Position = position
+		if _, err := entry.Update(ctx, tx, boil.Whitelist("position")); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetInvitations returns the posts a user is invited to co-author, newest invitation first
+func GetInvitations(ctx context.Context, db *sql.DB, userID int) (*models.PostSlice, error) {
+	posts, err := models.Posts(append([]qm.QueryMod{
+		qm.InnerJoin("post_authors ON post_authors.post_id = posts.id"),
+		qm.Where("post_authors.user_id = ? AND post_authors.accepted_at IS NULL", userID),
+		qm.OrderBy("post_authors.created_at DESC"),
+	}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// isCoAuthor reports whether a user accepted to co-author a post
+func isCoAuthor(ctx context.Context, db *sql.DB, postID int, userID int) (bool, error) {
+	return models.PostAuthors(
+		qm.Where("post_id = ? AND user_id = ? AND accepted_at IS NOT NULL", postID, userID),
+	).Exists(ctx, db)
+}
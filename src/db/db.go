@@ -17,19 +17,9 @@ type Container struct {
 
 // Init returns a pointer to a DB container object after connecting to psql db
 func Init(l *logrus.Logger) *Container {
-	config := createConfig()
 	var container Container
 
-	psqlInfo := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		config.DBHost,
-		config.DBPort,
-		config.DBUsername,
-		config.DBPassword,
-		config.DBName,
-	)
-
-	db, err := sql.Open("postgres", psqlInfo)
+	db, err := sql.Open("postgres", DataSource())
 	if err != nil {
 		l.Error(err)
 	}
@@ -39,6 +29,19 @@ func Init(l *logrus.Logger) *Container {
 	return &Container{db, l, getEnv("JWT_SECRET", "")}
 }
 
+// DataSource returns the connection string of the psql db
+func DataSource() string {
+	config := createConfig()
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		config.DBHost,
+		config.DBPort,
+		config.DBUsername,
+		config.DBPassword,
+		config.DBName,
+	)
+}
+
 // Migrate performs db migration located in db/migration dir
 func (c *Container) Migrate(method string) error {
 	migrations := &migrate.FileMigrationSource{
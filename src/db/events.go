@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// eventBodyLength is the maximum length in characters of the text sent in an event.
+// Notifications carry at most 8000 bytes
+const eventBodyLength = 1000
+
+// publishEvent notifies the servers streaming real-time updates of an event on a topic.
+// The notification is only delivered once the transaction commits
+func publishEvent(ctx context.Context, exec boil.ContextExecutor, topic string, typ string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = queries.Raw(`
+		SELECT pg_notify($1, json_build_object(
+			'id', nextval('stream_event_ids'), 'topic', $2::text, 'type', $3::text, 'data', $4::json
+		)::text)`, stream.Channel, topic, typ, string(payload)).ExecContext(ctx, exec)
+	return err
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// Statuses of a post
+const (
+	StatusPublished = "published"
+	StatusScheduled = "scheduled"
+	StatusDraft     = "draft"
+)
+
+// PostFilter narrows down a list of posts. Zero values filter nothing
+type PostFilter struct {
+	// AllTags matches posts with every tag, AnyTags posts with at least one
+	AllTags      []string
+	AnyTags      []string
+	ExcludedTags []string
+	Authors      []string
+
+	// After bounds are inclusive, before bounds exclusive
+	PublishedAfter  time.Time
+	PublishedBefore time.Time
+	CreatedAfter    time.Time
+	CreatedBefore   time.Time
+
+	MinLikes int
+	Status   string
+	// Owner restricts the posts to those of an author.
+	// It must be set for any status but StatusPublished
+	Owner string
+}
+
+// GetPostsByFilter returns the posts matching a filter, newest first
+func GetPostsByFilter(ctx context.Context, db *sql.DB, f *PostFilter) (*models.PostSlice, error) {
+	if err := f.resolveTagAliases(ctx, db); err != nil {
+		return nil, err
+	}
+
+	mods := []qm.QueryMod{
+		qm.OrderBy("published_at DESC NULLS LAST, created_at DESC"),
+	}
+
+	switch f.Status {
+	case StatusScheduled:
+		mods = append(mods, qm.Where("published_at IS NULL AND publish_at IS NOT NULL"))
+	case StatusDraft:
+		mods = append(mods, qm.Where("published_at IS NULL AND publish_at IS NULL"))
+	default:
+		mods = append(mods, publishedOnly)
+	}
+	if f.Owner != "" {
+		mods = append(mods, qm.Where("author = ?", f.Owner))
+	}
+	for _, cond := range f.conditions() {
+		mods = append(mods, qm.Where(cond.clause, cond.arg))
+	}
+
+	posts, err := models.Posts(append(mods, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// filterCondition is a condition on posts with a single ? placeholder for its argument
+type filterCondition struct {
+	clause string
+	arg    interface{}
+}
+
+// resolveTagAliases replaces the tags of the filter given by one of their aliases with the slugs of the tags
+func (f *PostFilter) resolveTagAliases(ctx context.Context, exec boil.ContextExecutor) error {
+	for _, slugs := range [][]string{f.AllTags, f.AnyTags, f.ExcludedTags} {
+		for i, slug := range slugs {
+			tag, err := findTag(ctx, exec, slug)
+			if err == nil {
+				slugs[i] = tag.Slug
+			} else if err != sql.ErrNoRows {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// conditions returns the conditions narrowing down posts by tags, authors, dates and likes,
+// shared by lists of posts and searches
+func (f *PostFilter) conditions() []filterCondition {
+	var conds []filterCondition
+	if len(f.AllTags) > 0 {
+		conds = append(conds, filterCondition{"tags @> ?", pq.Array(f.AllTags)})
+	}
+	if len(f.AnyTags) > 0 {
+		conds = append(conds, filterCondition{"tags && ?", pq.Array(f.AnyTags)})
+	}
+	if len(f.ExcludedTags) > 0 {
+		conds = append(conds, filterCondition{"NOT coalesce(tags, '{}') && ?", pq.Array(f.ExcludedTags)})
+	}
+	if len(f.Authors) > 0 {
+		conds = append(conds, filterCondition{"author = ANY(?)", pq.Array(f.Authors)})
+	}
+
+	if !f.PublishedAfter.IsZero() {
+		conds = append(conds, filterCondition{"published_at >= ?", f.PublishedAfter})
+	}
+	if !f.PublishedBefore.IsZero() {
+		conds = append(conds, filterCondition{"published_at < ?", f.PublishedBefore})
+	}
+	if !f.CreatedAfter.IsZero() {
+		conds = append(conds, filterCondition{"created_at >= ?", f.CreatedAfter})
+	}
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, filterCondition{"created_at < ?", f.CreatedBefore})
+	}
+	if f.MinLikes > 0 {
+		conds = append(conds, filterCondition{"likes >= ?", f.MinLikes})
+	}
+	return conds
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// FollowUser makes a user follow another user, notifying them the first time
+func FollowUser(ctx context.Context, db *sql.DB, follower *models.User, followeeID int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := queries.Raw(`
+		INSERT INTO user_follows (follower_id, followee_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, follower.ID, followeeID).ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if followed, err := result.RowsAffected(); err != nil {
+		return err
+	} else if followed > 0 {
+		err := notify(ctx, tx, &Notification{
+			UserID:    followeeID,
+			Type:      NotificationFollow,
+			GroupKey:  NotificationFollow,
+			ActorID:   null.IntFrom(follower.ID),
+			ActorName: Username(follower),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// UnfollowUser makes a user stop following another user
+func UnfollowUser(ctx context.Context, db *sql.DB, followerID int, followeeID int) error {
+	_, err := models.UserFollows(qm.Where("follower_id = ? AND followee_id = ?", followerID, followeeID)).DeleteAll(ctx, db)
+	return err
+}
+
+// IsFollowingUser reports whether a user follows another user
+func IsFollowingUser(ctx context.Context, db *sql.DB, followerID int, followeeID int) (bool, error) {
+	return models.UserFollowExists(ctx, db, followerID, followeeID)
+}
+
+// CountFollowers returns the number of followers of a user
+func CountFollowers(ctx context.Context, db *sql.DB, userID int) (int64, error) {
+	return models.UserFollows(qm.Where("followee_id = ?", userID)).Count(ctx, db)
+}
@@ -0,0 +1,168 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/anchor"
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// ErrQuoteNotFound is returned when the quote of a highlight cannot be found in its post
+var ErrQuoteNotFound = errors.New("Quote not found in the post.")
+
+// LocatedHighlight is a highlight with where its quote is in the current text of its post.
+// Located is false when the passage has been edited beyond recognition
+type LocatedHighlight struct {
+	Highlight *models.Highlight
+	Range     anchor.Range
+	Located   bool
+}
+
+// Passage is a span of a post with the number of readers who highlighted it
+type Passage struct {
+	Range anchor.Range
+	Quote string
+	Count int
+}
+
+// CreateHighlight highlights a passage of the current revision of a post for a user.
+// The passage is found by its quote, near the given range when it occurs more than once
+func CreateHighlight(ctx context.Context, db *sql.DB, post *models.Post, userID int, r anchor.Range, quote string, note null.String) (*LocatedHighlight, error) {
+	text := markdown.PlainText(post.Document.String)
+	located, ok := locate(text, r, quote)
+	if !ok {
+		return nil, ErrQuoteNotFound
+	}
+
+	revision, err := models.PostRevisions(
+		qm.Where("post_id = ?", post.ID),
+		qm.OrderBy("revision DESC"),
+	).One(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	highlight := &models.Highlight{
+		PostID:      post.ID,
+		UserID:      userID,
+		Revision:    revision.Revision,
+		StartOffset: located.Start,
+		EndOffset:   located.End,
+		Quote:       located.Slice(text),
+		Note:        note,
+	}
+	if err := highlight.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return &LocatedHighlight{highlight, located, true}, nil
+}
+
+// GetUserHighlights returns the highlights of a user on a post located in its current text,
+// in the order they appear
+func GetUserHighlights(ctx context.Context, db *sql.DB, post *models.Post, userID int) ([]*LocatedHighlight, error) {
+	highlights, err := models.Highlights(
+		qm.Where("post_id = ? AND user_id = ?", post.ID, userID),
+		qm.OrderBy("id"),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	located := locateHighlights(post, highlights)
+	sort.SliceStable(located, func(i, j int) bool {
+		return located[i].Located && (!located[j].Located || located[i].Range.Start < located[j].Range.Start)
+	})
+	return located, nil
+}
+
+// GetTopPassages returns the passages of a post highlighted by the most readers
+func GetTopPassages(ctx context.Context, db *sql.DB, post *models.Post, limit int) ([]*Passage, error) {
+	highlights, err := models.Highlights(qm.Where("post_id = ?", post.ID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	text := markdown.PlainText(post.Document.String)
+	readers := make(map[anchor.Range]map[int]bool)
+	for _, h := range locateHighlights(post, highlights) {
+		if !h.Located {
+			continue
+		}
+		if readers[h.Range] == nil {
+			readers[h.Range] = make(map[int]bool)
+		}
+		readers[h.Range][h.Highlight.UserID] = true
+	}
+
+	passages := make([]*Passage, 0, len(readers))
+	for r, users := range readers {
+		passages = append(passages, &Passage{r, r.Slice(text), len(users)})
+	}
+	sort.Slice(passages, func(i, j int) bool {
+		if passages[i].Count != passages[j].Count {
+			return passages[i].Count > passages[j].Count
+		}
+		return passages[i].Range.Start < passages[j].Range.Start
+	})
+	if len(passages) > limit {
+		passages = passages[:limit]
+	}
+	return passages, nil
+}
+
+// GetUserHighlight returns a highlight of a user on a post by its ID
+func GetUserHighlight(ctx context.Context, db *sql.DB, postID int, userID int, id int64) (*models.Highlight, error) {
+	return models.Highlights(qm.Where("id = ? AND post_id = ? AND user_id = ?", id, postID, userID)).One(ctx, db)
+}
+
+// UpdateHighlightNote replaces the private note of a highlight
+func UpdateHighlightNote(ctx context.Context, db *sql.DB, highlight *models.Highlight, note null.String) error {
+	highlight.Note = note
+	_, err := highlight.Update(ctx, db, boil.Whitelist("note"))
+	return err
+}
+
+// DeleteHighlight deletes a highlight
+func DeleteHighlight(ctx context.Context, db *sql.DB, highlight *models.Highlight) error {
+	_, err := highlight.Delete(ctx, db)
+	return err
+}
+
+// locateHighlights finds the highlights in the current text of their post.
+// Highlights whose offsets still hold their quote stay put; the others are re-anchored by their quote,
+// once for every passage highlighted by several readers
+func locateHighlights(post *models.Post, highlights models.HighlightSlice) []*LocatedHighlight {
+	type passage struct {
+		r     anchor.Range
+		quote string
+	}
+	text := markdown.PlainText(post.Document.String)
+	found := make(map[passage]*LocatedHighlight)
+	located := make([]*LocatedHighlight, len(highlights))
+	for i, h := range highlights {
+		p := passage{anchor.Range{Start: h.StartOffset, End: h.EndOffset}, h.Quote}
+		if f, ok := found[p]; ok {
+			located[i] = &LocatedHighlight{h, f.Range, f.Located}
+			continue
+		}
+		r, ok := locate(text, p.r, p.quote)
+		located[i] = &LocatedHighlight{h, r, ok}
+		found[p] = located[i]
+	}
+	return located
+}
+
+func locate(text string, r anchor.Range, quote string) (anchor.Range, bool) {
+	if r.Valid(len([]rune(text))) && r.Slice(text) == quote {
+		return r, true
+	}
+	return anchor.Locate(text, quote, r.Start)
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// LikePost records that a user likes a post and returns its like count.
+// Liking a post twice has no effect
+func LikePost(ctx context.Context, db *sql.DB, userID int, postID int64) (int, error) {
+	return changeLike(ctx, db, postID, 1, `
+		INSERT INTO post_likes (user_id, post_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, userID)
+}
+
+// UnlikePost removes the like of a user from a post and returns its like count
+func UnlikePost(ctx context.Context, db *sql.DB, userID int, postID int64) (int, error) {
+	return changeLike(ctx, db, postID, -1, `
+		DELETE FROM post_likes WHERE user_id = $1 AND post_id = $2`, userID)
+}
+
+// changeLike runs a statement adding or removing a like and,
+// when it changed anything, moves the like count of the post by delta and streams it
+func changeLike(ctx context.Context, db *sql.DB, postID int64, delta int, query string, userID int) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Only posts that have gone live can be liked
+	post, err := models.Posts(qm.Where("id = ?", postID), publishedOnly).One(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := queries.Raw(query, userID, post.ID).ExecContext(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if changed, err := result.RowsAffected(); err != nil {
+		return 0, err
+	} else if changed > 0 {
+		var likes struct {
+			Likes int `boil:"likes"`
+		}
+		err := queries.Raw(`UPDATE posts SET likes = greatest(coalesce(likes, 0) + $2, 0) WHERE id = $1 RETURNING likes`,
+			post.ID, delta).Bind(ctx, tx, &likes)
+		if err != nil {
+			return 0, err
+		}
+		err = publishEvent(ctx, tx, stream.PostLikesTopic(post.ID), stream.EventLikes, map[string]interface{}{
+			"post_id": post.ID,
+			"likes":   likes.Likes,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if delta > 0 {
+			if err := notifyLike(ctx, tx, post, userID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return GetLikesForPost(ctx, db, postID)
+}
+
+// notifyLike notifies the author of a post that a user liked it
+func notifyLike(ctx context.Context, exec boil.ContextExecutor, post *models.Post, userID int) error {
+	user, err := models.FindUser(ctx, exec, userID)
+	if err != nil {
+		return err
+	}
+	return notifyPostAuthor(ctx, exec, post, NotificationLike, null.IntFrom(user.ID), Username(user), "")
+}
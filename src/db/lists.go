@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// DefaultListName is the name of the list every user saves posts to by default
+const DefaultListName = "Saved"
+
+// ErrListExists is returned when a user already has a list with the same name
+var ErrListExists = errors.New("List already exists.")
+
+// ErrDefaultList is returned when deleting the default list of a user
+var ErrDefaultList = errors.New("The default list cannot be deleted.")
+
+// ErrInvalidOrder is returned when a new order is not made of exactly the posts in a list
+var ErrInvalidOrder = errors.New("Order must contain every post of the list once.")
+
+// ReadingListCount is a reading list with the number of posts in it
+type ReadingListCount struct {
+	models.ReadingList `boil:",bind"`
+	PostCount          int64 `boil:"post_count"`
+}
+
+// GetDefaultList returns the default list of a user, creating it on first use
+func GetDefaultList(ctx context.Context, db *sql.DB, userID int) (*models.ReadingList, error) {
+	_, err := queries.Raw(`
+		INSERT INTO reading_lists (user_id, name, is_default) VALUES ($1, $2, true)
+		ON CONFLICT DO NOTHING`, userID, DefaultListName).ExecContext(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return models.ReadingLists(qm.Where("user_id = ? AND is_default", userID)).One(ctx, db)
+}
+
+// GetUserLists returns the lists of a user with their post counts, the default list first.
+// Private lists are left out unless includePrivate is set
+func GetUserLists(ctx context.Context, db *sql.DB, userID int, includePrivate bool) ([]*ReadingListCount, error) {
+	mods := []qm.QueryMod{
+		qm.Select("reading_lists.*", "count(posts.id) AS post_count"),
+		qm.From("reading_lists"),
+		qm.LeftOuterJoin("reading_list_posts ON reading_list_posts.list_id = reading_lists.id"),
+		qm.LeftOuterJoin("posts ON posts.id = reading_list_posts.post_id AND posts.deleted_at IS NULL AND posts.published_at IS NOT NULL"),
+		qm.Where("reading_lists.user_id = ?", userID),
+		qm.GroupBy("reading_lists.id"),
+		qm.OrderBy("reading_lists.is_default DESC, reading_lists.created_at, reading_lists.id"),
+	}
+	if !includePrivate {
+		mods = append(mods, qm.Where("reading_lists.is_public"))
+	}
+
+	lists := []*ReadingListCount{}
+	if err := models.NewQuery(mods...).Bind(ctx, db, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// GetListByID returns a reading list by its ID
+func GetListByID(ctx context.Context, db *sql.DB, id int64) (*models.ReadingList, error) {
+	return models.ReadingLists(qm.Where("id = ?", id)).One(ctx, db)
+}
+
+// CreateList creates a named list for a user
+func CreateList(ctx context.Context, db *sql.DB, userID int, name string, public bool) (*models.ReadingList, error) {
+	// The default list reserves its name once it exists
+	if _, err := GetDefaultList(ctx, db, userID); err != nil {
+		return nil, err
+	}
+
+	exists, err := models.ReadingLists(qm.Where("user_id = ? AND name = ?", userID, name)).Exists(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrListExists
+	}
+
+	list := &models.ReadingList{UserID: userID, Name: name, IsPublic: public}
+	if err := list.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// DeleteList deletes a list and the bookmarks in it. The default list cannot be deleted
+func DeleteList(ctx context.Context, db *sql.DB, list *models.ReadingList) error {
+	if list.IsDefault {
+		return ErrDefaultList
+	}
+	_, err := list.Delete(ctx, db)
+	return err
+}
+
+// GetListPosts returns the published posts in a list in their order
+func GetListPosts(ctx context.Context, db *sql.DB, listID int) (*models.PostSlice, error) {
+	posts, err := models.Posts(append([]qm.QueryMod{
+		publishedOnly,
+		qm.InnerJoin("reading_list_posts ON reading_list_posts.post_id = posts.id"),
+		qm.Where("reading_list_posts.list_id = ?", listID),
+		qm.OrderBy("reading_list_posts.position"),
+	}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// AddPostToList appends a post to a list. Adding a post twice has no effect
+func AddPostToList(ctx context.Context, db *sql.DB, listID int, postID int) error {
+	_, err := queries.Raw(`
+		INSERT INTO reading_list_posts (list_id, post_id, position)
+		SELECT $1, $2, coalesce(max(position) + 1, 0) FROM reading_list_posts WHERE list_id = $1
+		ON CONFLICT DO NOTHING`, listID, postID).ExecContext(ctx, db)
+	return err
+}
+
+// RemovePostFromList removes a post from a list
+func RemovePostFromList(ctx context.Context, db *sql.DB, listID int, postID int) error {
+	_, err := models.ReadingListPosts(qm.Where("list_id = ? AND post_id = ?", listID, postID)).DeleteAll(ctx, db)
+	return err
+}
+
+// ReorderList puts the posts of a list in the given order,
+// which must contain every post in the list exactly once
+func ReorderList(ctx context.Context, db *sql.DB, listID int, postIDs []int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	entries, err := models.ReadingListPosts(qm.Where("list_id = ?", listID), qm.For("UPDATE")).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[int]int, len(postIDs))
+	for i, id := range postIDs {
+		positions[id] = i
+	}
+	if len(positions) != len(postIDs) || len(positions) != len(entries) {
+		return ErrInvalidOrder
+	}
+
+	for _, entry := range entries {
+		position, ok := positions[entry.PostID]
+		if !ok {
+			return ErrInvalidOrder
+		}
+		entry.Position = position
+		if _, err := entry.Update(ctx, tx, boil.Whitelist("position")); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetBookmarkedPostIDs returns which of the given posts a user has saved to any of their lists
+func GetBookmarkedPostIDs(ctx context.Context, db *sql.DB, userID int, postIDs []int) (map[int]bool, error) {
+	bookmarked := make(map[int]bool)
+	if len(postIDs) == 0 {
+		return bookmarked, nil
+	}
+
+	ids := make([]interface{}, len(postIDs))
+	for i, id := range postIDs {
+		ids[i] = id
+	}
+	entries, err := models.ReadingListPosts(
+		qm.InnerJoin("reading_lists ON reading_lists.id = reading_list_posts.list_id"),
+		qm.Where("reading_lists.user_id = ?", userID),
+		qm.WhereIn("reading_list_posts.post_id IN ?", ids...),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		bookmarked[entry.PostID] = true
+	}
+	return bookmarked, nil
+}
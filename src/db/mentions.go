@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// ProfileURL returns the URL of the profile of a user, which mentions link to
+func ProfileURL(userID int) string {
+	return fmt.Sprintf("/api/v1/users/%d", userID)
+}
+
+// resolveMentions looks up the users mentioned in the document of a post and renders
+// their mentions as links to their profiles. Users who block the author
+// or the editor of the post cannot be mentioned by them
+func resolveMentions(ctx context.Context, exec boil.ContextExecutor, post *models.Post, editor string) (models.UserSlice, error) {
+	handles := markdown.Mentions(post.Document.String)
+	if len(handles) == 0 {
+		return nil, nil
+	}
+
+	users, err := models.Users(
+		qm.Where("lower(split_part(email, '@', 1)) = ANY(?)", types.StringArray(handles)),
+		qm.Where(`NOT EXISTS (
+			SELECT 1 FROM user_blocks JOIN users AS mentioners ON mentioners.id = user_blocks.blocked_id
+			WHERE user_blocks.blocker_id = users.id AND lower(split_part(mentioners.email, '@', 1)) = ANY(?)
+		)`, types.StringArray{strings.ToLower(post.Author.String), strings.ToLower(editor)}),
+		qm.OrderBy("id"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	users = firstPerUsername(users)
+
+	profiles := make(map[string]string, len(users))
+	for _, u := range users {
+		profiles[strings.ToLower(Username(u))] = ProfileURL(u.ID)
+	}
+	rendered := markdown.RenderWithMentions(post.Document.String, profiles)
+	post.DocumentHTML = null.StringFrom(rendered.HTML)
+	return users, nil
+}
+
+// recordMentions stores the users currently mentioned in a post. Mentions removed by an edit
+// are marked as removed rather than deleted, so adding them back does not notify again.
+// The mentioned users are notified once the post is published
+func recordMentions(ctx context.Context, exec boil.ContextExecutor, post *models.Post, users models.UserSlice) error {
+	ids := types.Int64Array{}
+	for _, u := range users {
+		_, err := queries.Raw(`
+			INSERT INTO post_mentions (post_id, user_id) VALUES ($1, $2)
+			ON CONFLICT (post_id, user_id) DO UPDATE SET removed_at = NULL`, post.ID, u.ID).ExecContext(ctx, exec)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, int64(u.ID))
+	}
+
+	_, err := models.PostMentions(
+		qm.Where("post_id = ? AND removed_at IS NULL AND NOT (user_id = ANY(?))", post.ID, ids),
+	).UpdateAll(ctx, exec, models.M{"removed_at": time.Now()})
+	if err != nil {
+		return err
+	}
+
+	if !post.PublishedAt.Valid {
+		return nil
+	}
+	return notifyMentions(ctx, exec, post)
+}
+
+// notifyMentions notifies the users mentioned in a published post who were not notified yet
+func notifyMentions(ctx context.Context, exec boil.ContextExecutor, post *models.Post) error {
+	mentions, err := models.PostMentions(
+		qm.Where("post_id = ? AND removed_at IS NULL AND notified_at IS NULL", post.ID),
+	).All(ctx, exec)
+	if err != nil || len(mentions) == 0 {
+		return err
+	}
+
+	actorID := null.Int{}
+	if author, err := userByUsername(ctx, exec, post.Author.String); err == nil {
+		actorID = null.IntFrom(author.ID)
+	}
+	for _, m := range mentions {
+		err := notify(ctx, exec, &Notification{
+			UserID:    m.UserID,
+			Type:      NotificationMention,
+			PostID:    null.IntFrom(post.ID),
+			GroupKey:  NotificationMention + ":post:" + strconv.Itoa(post.ID),
+			ActorID:   actorID,
+			ActorName: post.Author.String,
+			Body:      post.Title.String,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	_, err = mentions.UpdateAll(ctx, exec, models.M{"notified_at": time.Now()})
+	return err
+}
+
+// mentionInComment records and notifies the users mentioned in a comment left through a share link.
+// Reviewers are anonymous, so only the authors of the post can be mentioned
+func mentionInComment(ctx context.Context, exec boil.ContextExecutor, post *models.Post, comment *models.ShareLinkComment) error {
+	handles := markdown.Mentions(comment.Body)
+	if len(handles) == 0 {
+		return nil
+	}
+
+	users, err := models.Users(
+		qm.Where("lower(split_part(email, '@', 1)) = ANY(?)", types.StringArray(handles)),
+		qm.Where(`(lower(split_part(email, '@', 1)) = ? OR id IN (
+			SELECT user_id FROM post_authors WHERE post_id = ? AND accepted_at IS NOT NULL
+		))`, strings.ToLower(post.Author.String), post.ID),
+		qm.OrderBy("id"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	users = firstPerUsername(users)
+
+	mentions := make([]*models.CommentMention, len(users))
+	for i, u := range users {
+		mentions[i] = &models.CommentMention{UserID: u.ID}
+	}
+	if err := comment.AddCommentCommentMentions(ctx, exec, true, mentions...); err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		// The author is already notified of the comment itself
+		if strings.EqualFold(Username(u), post.Author.String) {
+			continue
+		}
+		err := notify(ctx, exec, &Notification{
+			UserID:    u.ID,
+			Type:      NotificationMention,
+			PostID:    null.IntFrom(post.ID),
+			GroupKey:  NotificationMention + ":comment:" + strconv.Itoa(comment.ID),
+			ActorName: comment.Name,
+			Body:      comment.Body,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// firstPerUsername keeps the first of the users writing under the same name, like userByUsername
+func firstPerUsername(users models.UserSlice) models.UserSlice {
+	seen := map[string]bool{}
+	unique := models.UserSlice{}
+	for _, u := range users {
+		name := strings.ToLower(Username(u))
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, u)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ;
+
+-- Posts written before scheduling existed were published on creation
+UPDATE posts SET published_at = created_at WHERE published_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS publish_at_index ON posts (publish_at) WHERE published_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS publish_at_index;
+ALTER TABLE posts DROP COLUMN IF EXISTS published_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS publish_at;
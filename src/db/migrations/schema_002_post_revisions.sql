@@ -0,0 +1,22 @@
+-- +migrate Up
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS title varchar(255);
+
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id SERIAL PRIMARY KEY,
+    post_id int NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+    revision int NOT NULL,
+    title varchar(255),
+    document text,
+    tags text[],
+    editor varchar(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (post_id, revision)
+);
+
+-- Existing posts start their history with their current content
+INSERT INTO post_revisions (post_id, revision, title, document, tags, editor, created_at)
+    SELECT id, 1, title, document, tags, author, updated_at FROM posts;
+
+-- +migrate Down
+DROP TABLE post_revisions;
+ALTER TABLE posts DROP COLUMN IF EXISTS title;
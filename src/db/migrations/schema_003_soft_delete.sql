@@ -0,0 +1,12 @@
+-- +migrate Up
+-- delete_stamp fired on every update, stamping edited posts as deleted
+DROP TRIGGER IF EXISTS delete_stamp ON posts;
+UPDATE posts SET deleted_at = NULL WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS deleted_at_index ON posts (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS deleted_at_index;
+
+CREATE TRIGGER delete_stamp BEFORE UPDATE ON posts
+    FOR EACH ROW EXECUTE PROCEDURE delete_timestamp();
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Rendered from the markdown document on save, NULL until then
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS document_html text;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS table_of_contents jsonb;
+
+-- +migrate Down
+ALTER TABLE posts DROP COLUMN IF EXISTS table_of_contents;
+ALTER TABLE posts DROP COLUMN IF EXISTS document_html;
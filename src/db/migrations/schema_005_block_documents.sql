@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Block tree of the document as produced by the editor, NULL for posts
+-- written before blocks existed until they are next saved
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS blocks jsonb;
+
+-- +migrate Down
+ALTER TABLE posts DROP COLUMN IF EXISTS blocks;
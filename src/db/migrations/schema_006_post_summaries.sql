@@ -0,0 +1,10 @@
+-- +migrate Up
+-- Computed from the document on save, NULL for posts saved before
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS word_count integer;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS reading_time integer;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS excerpt text;
+
+-- +migrate Down
+ALTER TABLE posts DROP COLUMN IF EXISTS excerpt;
+ALTER TABLE posts DROP COLUMN IF EXISTS reading_time;
+ALTER TABLE posts DROP COLUMN IF EXISTS word_count;
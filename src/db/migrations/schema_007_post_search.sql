@@ -0,0 +1,20 @@
+-- +migrate Up
+-- array_to_string is only stable, generated columns need an immutable expression
+-- +migrate StatementBegin
+CREATE OR REPLACE FUNCTION tags_to_text(tags text[]) RETURNS text AS $$
+    SELECT coalesce(array_to_string(tags, ' '), '');
+$$ LANGUAGE sql IMMUTABLE;
+-- +migrate StatementEnd
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', tags_to_text(tags)), 'B') ||
+    setweight(to_tsvector('english', coalesce(document, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS search_vector_index ON posts USING GIN(search_vector);
+
+-- +migrate Down
+DROP INDEX IF EXISTS search_vector_index;
+ALTER TABLE posts DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS tags_to_text(text[]);
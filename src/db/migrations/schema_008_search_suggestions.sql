@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS title_trgm_index ON posts USING GIN(title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS author_trgm_index ON posts USING GIN(author gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS tags_trgm_index ON posts USING GIN(tags_to_text(tags) gin_trgm_ops);
+
+-- +migrate Down
+DROP INDEX IF EXISTS tags_trgm_index;
+DROP INDEX IF EXISTS author_trgm_index;
+DROP INDEX IF EXISTS title_trgm_index;
@@ -0,0 +1,63 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    slug varchar(255) NOT NULL UNIQUE,
+    name varchar(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Other slugs that resolve to a tag, managed by admins
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    alias varchar(255) PRIMARY KEY,
+    tag_id integer NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- posts.tags keeps the slugs in order for filtering and search
+CREATE TABLE IF NOT EXISTS post_tags (
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    tag_id integer NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_tags_tag_id_index ON post_tags (tag_id);
+
+CREATE TABLE IF NOT EXISTS tag_follows (
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    tag_id integer NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, tag_id)
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin boolean NOT NULL DEFAULT false;
+
+-- Normalize the existing free-form tags: trimmed, case folded, inner whitespace
+-- as dashes, without empty or duplicate ones
+UPDATE posts SET tags = ARRAY(
+    SELECT slug FROM (
+        SELECT lower(regexp_replace(trim(tag), '\s+', '-', 'g')) AS slug, min(ord) AS ord
+        FROM unnest(posts.tags) WITH ORDINALITY AS t (tag, ord)
+        GROUP BY 1
+    ) normalized
+    WHERE slug <> ''
+    ORDER BY ord
+)
+WHERE tags IS NOT NULL;
+
+INSERT INTO tags (slug, name)
+SELECT DISTINCT tag, tag FROM posts, unnest(posts.tags) AS tag
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO post_tags (post_id, tag_id, position)
+SELECT posts.id, tags.id, t.ord - 1
+FROM posts, unnest(posts.tags) WITH ORDINALITY AS t (slug, ord)
+JOIN tags ON tags.slug = t.slug
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+DROP TABLE IF EXISTS tag_follows;
+DROP TABLE IF EXISTS post_tags;
+DROP TABLE IF EXISTS tag_aliases;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Views per post and day, kept apart from posts so that reads do not bump updated_at
+CREATE TABLE IF NOT EXISTS post_views (
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    day date NOT NULL,
+    views integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (post_id, day)
+);
+
+-- Trending scores per period, recomputed periodically
+CREATE TABLE IF NOT EXISTS post_rankings (
+    period varchar(16) NOT NULL,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    score double precision NOT NULL,
+    rank integer NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (period, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_rankings_rank_index ON post_rankings (period, rank);
+
+-- +migrate Down
+DROP TABLE IF EXISTS post_rankings;
+DROP TABLE IF EXISTS post_views;
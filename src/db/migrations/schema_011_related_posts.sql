@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Which users liked which posts, so that posts liked by the same readers can be related
+CREATE TABLE IF NOT EXISTS post_likes (
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_likes_post_id_index ON post_likes (post_id);
+
+-- Cached related posts of a post, best first
+CREATE TABLE IF NOT EXISTS related_posts (
+    post_id integer PRIMARY KEY REFERENCES posts (id) ON DELETE CASCADE,
+    related_ids integer[] NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS related_posts_related_ids_index ON related_posts USING GIN (related_ids);
+
+-- +migrate Down
+DROP TABLE IF EXISTS related_posts;
+DROP TABLE IF EXISTS post_likes;
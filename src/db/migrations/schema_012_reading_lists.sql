@@ -0,0 +1,27 @@
+-- +migrate Up
+-- Named lists of posts saved by a user. Every user has a default "Saved" list
+CREATE TABLE IF NOT EXISTS reading_lists (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name varchar(255) NOT NULL,
+    is_default boolean NOT NULL DEFAULT false,
+    is_public boolean NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS reading_lists_default_index ON reading_lists (user_id) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS reading_list_posts (
+    list_id integer NOT NULL REFERENCES reading_lists (id) ON DELETE CASCADE,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (list_id, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS reading_list_posts_post_id_index ON reading_list_posts (post_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS reading_list_posts;
+DROP TABLE IF EXISTS reading_lists;
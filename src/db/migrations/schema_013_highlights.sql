@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Passages of posts highlighted by readers. Offsets are characters of the plain text
+-- of the revision the highlight was made on; the quote re-anchors it in later revisions
+CREATE TABLE IF NOT EXISTS highlights (
+    id SERIAL PRIMARY KEY,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    revision integer NOT NULL,
+    start_offset integer NOT NULL,
+    end_offset integer NOT NULL,
+    quote text NOT NULL,
+    note text,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS highlights_post_id_index ON highlights (post_id, user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS highlights;
@@ -0,0 +1,20 @@
+-- +migrate Up
+-- Ordered collections of posts by one author. A post belongs to at most one series
+CREATE TABLE IF NOT EXISTS series (
+    id SERIAL PRIMARY KEY,
+    author varchar(255) NOT NULL,
+    title varchar(255) NOT NULL,
+    description text,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS series_posts (
+    series_id integer NOT NULL REFERENCES series (id) ON DELETE CASCADE,
+    post_id integer NOT NULL UNIQUE REFERENCES posts (id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    PRIMARY KEY (series_id, post_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS series_posts;
+DROP TABLE IF EXISTS series;
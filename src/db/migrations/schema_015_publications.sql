@@ -0,0 +1,45 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS publications (
+    id SERIAL PRIMARY KEY,
+    slug varchar(255) NOT NULL UNIQUE,
+    name varchar(255) NOT NULL,
+    description text,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Owners manage members, editors review submissions and edit published posts, writers submit drafts
+CREATE TABLE IF NOT EXISTS publication_members (
+    publication_id integer NOT NULL REFERENCES publications (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role varchar(16) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (publication_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS publication_members_user_id_index ON publication_members (user_id);
+
+-- Drafts submitted to a publication and their review
+CREATE TABLE IF NOT EXISTS submissions (
+    id SERIAL PRIMARY KEY,
+    publication_id integer NOT NULL REFERENCES publications (id) ON DELETE CASCADE,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    submitted_by integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    status varchar(16) NOT NULL DEFAULT 'pending',
+    note text,
+    reviewed_by integer REFERENCES users (id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS submissions_pending_index ON submissions (post_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS submissions_publication_id_index ON submissions (publication_id, status);
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS publication_id integer REFERENCES publications (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS posts_publication_id_index ON posts (publication_id);
+
+-- +migrate Down
+ALTER TABLE posts DROP COLUMN IF EXISTS publication_id;
+DROP TABLE IF EXISTS submissions;
+DROP TABLE IF EXISTS publication_members;
+DROP TABLE IF EXISTS publications;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- Co-authors of a post besides its primary author in posts.author.
+-- An invitation counts once the co-author accepts it
+CREATE TABLE IF NOT EXISTS post_authors (
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    position integer NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_authors_user_id_idx ON post_authors (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS post_authors;
@@ -0,0 +1,30 @@
+-- +migrate Up
+-- Posts only subscribers read in full. Other readers get a preview
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS members_only boolean NOT NULL DEFAULT false;
+
+-- One subscription per user. A canceled subscription stays valid until the end of the paid period
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL UNIQUE REFERENCES users (id) ON DELETE CASCADE,
+    tier varchar(32) NOT NULL,
+    period varchar(16) NOT NULL,
+    status varchar(16) NOT NULL,
+    provider_id varchar(255) NOT NULL,
+    current_period_end TIMESTAMPTZ NOT NULL,
+    canceled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Members-only posts read for free, counted per reader and calendar month
+CREATE TABLE IF NOT EXISTS metered_reads (
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    month date NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, month, post_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS metered_reads;
+DROP TABLE IF EXISTS subscriptions;
+ALTER TABLE posts DROP COLUMN IF EXISTS members_only;
@@ -0,0 +1,38 @@
+-- +migrate Up
+-- Secret links to unpublished posts. Only a hash of the token is stored,
+-- the token itself is shown once when the link is created
+CREATE TABLE IF NOT EXISTS share_links (
+    id SERIAL PRIMARY KEY,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    token_hash varchar(64) NOT NULL UNIQUE,
+    allow_comments boolean NOT NULL DEFAULT false,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS share_links_post_id_idx ON share_links (post_id);
+
+CREATE TABLE IF NOT EXISTS share_link_accesses (
+    id SERIAL PRIMARY KEY,
+    link_id integer NOT NULL REFERENCES share_links (id) ON DELETE CASCADE,
+    ip varchar(64),
+    user_agent text,
+    accessed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS share_link_accesses_link_id_idx ON share_link_accesses (link_id, accessed_at);
+
+-- Feedback left by reviewers through links that allow comments
+CREATE TABLE IF NOT EXISTS share_link_comments (
+    id SERIAL PRIMARY KEY,
+    link_id integer NOT NULL REFERENCES share_links (id) ON DELETE CASCADE,
+    name varchar(255) NOT NULL,
+    body text NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS share_link_comments;
+DROP TABLE IF EXISTS share_link_accesses;
+DROP TABLE IF EXISTS share_links;
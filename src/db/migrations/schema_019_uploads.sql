@@ -0,0 +1,17 @@
+-- +migrate Up
+-- Uploaded images. Blobs are content-addressed by the SHA-256 of their bytes,
+-- so the same image uploaded twice is stored once
+CREATE TABLE IF NOT EXISTS uploads (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    hash varchar(64) NOT NULL,
+    content_type varchar(64) NOT NULL,
+    size bigint NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, hash)
+);
+
+CREATE INDEX IF NOT EXISTS uploads_hash_idx ON uploads (hash);
+
+-- +migrate Down
+DROP TABLE IF EXISTS uploads;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Dimensions and blur hash placeholder of uploaded images
+ALTER TABLE uploads ADD COLUMN IF NOT EXISTS width integer NOT NULL DEFAULT 0;
+ALTER TABLE uploads ADD COLUMN IF NOT EXISTS height integer NOT NULL DEFAULT 0;
+ALTER TABLE uploads ADD COLUMN IF NOT EXISTS blur_hash varchar(64) NOT NULL DEFAULT '';
+
+-- Downscaled copies of uploaded images for responsive srcsets
+CREATE TABLE IF NOT EXISTS upload_variants (
+    upload_id integer NOT NULL REFERENCES uploads (id) ON DELETE CASCADE,
+    width integer NOT NULL,
+    height integer NOT NULL,
+    content_type varchar(64) NOT NULL,
+    size bigint NOT NULL,
+    PRIMARY KEY (upload_id, width)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS upload_variants;
+ALTER TABLE uploads DROP COLUMN IF EXISTS blur_hash;
+ALTER TABLE uploads DROP COLUMN IF EXISTS height;
+ALTER TABLE uploads DROP COLUMN IF EXISTS width;
@@ -0,0 +1,20 @@
+-- +migrate Up
+-- Images embedded in post documents. Uploads whose image no post refers to
+-- are swept after a grace period
+CREATE TABLE IF NOT EXISTS upload_refs (
+    hash varchar(64) NOT NULL,
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (hash, post_id)
+);
+
+CREATE INDEX IF NOT EXISTS upload_refs_post_id_idx ON upload_refs (post_id);
+
+-- Posts written before references were tracked
+INSERT INTO upload_refs (hash, post_id)
+SELECT DISTINCT m[1], posts.id
+FROM posts, regexp_matches(posts.document, '/uploads/([0-9a-f]{64})', 'g') AS m
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
+DROP TABLE IF EXISTS upload_refs;
@@ -0,0 +1,53 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS user_follows (
+    follower_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    followee_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_follows_followee_id_idx ON user_follows (followee_id);
+
+-- In-app notifications. Events with the same group key are aggregated
+-- into one notification while it is unread, e.g. the likes of a post
+CREATE TABLE IF NOT EXISTS notifications (
+    id SERIAL PRIMARY KEY,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type varchar(32) NOT NULL,
+    post_id integer REFERENCES posts (id) ON DELETE CASCADE,
+    group_key varchar(128) NOT NULL,
+    -- The latest actor. Anonymous actors, like share link reviewers, only have a name
+    actor_id integer REFERENCES users (id) ON DELETE SET NULL,
+    actor_name varchar(255) NOT NULL,
+    actor_count integer NOT NULL DEFAULT 1,
+    body text NOT NULL DEFAULT '',
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS notifications_unread_group_idx ON notifications (user_id, group_key) WHERE read_at IS NULL;
+CREATE INDEX IF NOT EXISTS notifications_user_id_updated_at_idx ON notifications (user_id, updated_at DESC, id DESC);
+
+-- The users aggregated into a notification, so that an actor is counted once
+CREATE TABLE IF NOT EXISTS notification_actors (
+    notification_id integer NOT NULL REFERENCES notifications (id) ON DELETE CASCADE,
+    actor_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (notification_id, actor_id)
+);
+
+-- Notification types a user turned off. Every type is on by default
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type varchar(32) NOT NULL,
+    enabled boolean NOT NULL,
+    PRIMARY KEY (user_id, type)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS notification_actors;
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS user_follows;
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- Users who block another user cannot be mentioned by them
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    blocked_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+-- The users mentioned in posts. A mention removed by an edit is kept with removed_at set,
+-- so adding it back does not notify the user again
+CREATE TABLE IF NOT EXISTS post_mentions (
+    post_id integer NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    removed_at TIMESTAMPTZ,
+    -- Mentions in drafts are notified when the post is published
+    notified_at TIMESTAMPTZ,
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS post_mentions_user_id_idx ON post_mentions (user_id);
+
+CREATE TABLE IF NOT EXISTS comment_mentions (
+    comment_id integer NOT NULL REFERENCES share_link_comments (id) ON DELETE CASCADE,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_id, user_id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS comment_mentions;
+DROP TABLE IF EXISTS post_mentions;
+DROP TABLE IF EXISTS user_blocks;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- IDs of the events streamed to clients, shared by every server instance
+-- so that a client can resume its stream on any of them
+CREATE SEQUENCE IF NOT EXISTS stream_event_ids;
+
+-- +migrate Down
+DROP SEQUENCE IF EXISTS stream_event_ids;
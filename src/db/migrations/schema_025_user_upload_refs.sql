@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar text;
+
+-- Images used by users, such as their avatar. Like the images embedded
+-- in posts, they keep their uploads from being swept
+CREATE TABLE IF NOT EXISTS user_upload_refs (
+    hash varchar(64) NOT NULL,
+    user_id integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (hash, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_upload_refs_user_id_idx ON user_upload_refs (user_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_upload_refs;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- Notifications are paged by creation, which aggregating events does not change
+DROP INDEX IF EXISTS notifications_user_id_updated_at_idx;
+CREATE INDEX IF NOT EXISTS notifications_user_id_created_at_idx ON notifications (user_id, created_at DESC, id DESC);
+
+-- Replies were never notified
+DELETE FROM notification_preferences WHERE type = 'reply';
+
+-- +migrate Down
+DROP INDEX IF EXISTS notifications_user_id_created_at_idx;
+CREATE INDEX IF NOT EXISTS notifications_user_id_updated_at_idx ON notifications (user_id, updated_at DESC, id DESC);
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Comments left through share links form threads. Signed in commenters, such as an
+-- author answering a reviewer, are kept so that replies to them can be notified
+ALTER TABLE share_link_comments ADD COLUMN IF NOT EXISTS parent_id integer REFERENCES share_link_comments (id) ON DELETE CASCADE;
+ALTER TABLE share_link_comments ADD COLUMN IF NOT EXISTS user_id integer REFERENCES users (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS share_link_comments_parent_id_idx ON share_link_comments (parent_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS share_link_comments_parent_id_idx;
+ALTER TABLE share_link_comments DROP COLUMN IF EXISTS user_id;
+ALTER TABLE share_link_comments DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- Like counts were once set by clients along with the post. Counts are now only kept
+-- by liking and unliking, so they are recomputed from the likes given
+UPDATE posts SET likes = (SELECT count(*) FROM post_likes WHERE post_likes.post_id = posts.id)
+WHERE likes IS DISTINCT FROM (SELECT count(*) FROM post_likes WHERE post_likes.post_id = posts.id);
+
+-- +migrate Down
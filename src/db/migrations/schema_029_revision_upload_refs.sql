@@ -0,0 +1,9 @@
+-- +migrate Up
+-- Images removed from a post stay referenced by the revisions that embed them.
+-- Restore the references dropped when images were removed from posts
+INSERT INTO upload_refs (hash, post_id)
+SELECT DISTINCT m[1], post_revisions.post_id
+FROM post_revisions, regexp_matches(post_revisions.document, '/uploads/([0-9a-f]{64})', 'g') AS m
+ON CONFLICT DO NOTHING;
+
+-- +migrate Down
@@ -0,0 +1,288 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// Notification types
+const (
+	NotificationFollow    = "follow"
+	NotificationLike      = "like"
+	NotificationComment   = "comment"
+	NotificationReply     = "reply"
+	NotificationMention   = "mention"
+	NotificationPublished = "published"
+)
+
+// NotificationTypes are the types of notifications users can turn on and off
+var NotificationTypes = []string{
+	NotificationFollow,
+	NotificationLike,
+	NotificationComment,
+	NotificationReply,
+	NotificationMention,
+	NotificationPublished,
+}
+
+// notificationBodyLength is the maximum length in characters of the text quoted in a notification
+const notificationBodyLength = 200
+
+// Notification is an event to notify a user of
+type Notification struct {
+	UserID int
+	Type   string
+	PostID null.Int
+	// GroupKey identifies the events aggregated into one notification while it is unread
+	GroupKey string
+	// ActorID is the user who caused the event, if it was not an anonymous reader
+	ActorID   null.Int
+	ActorName string
+	Body      string
+}
+
+// NotificationCursor is the position after which the next page of notifications starts.
+// Notifications are paged by when they were created, which aggregating events does not change
+type NotificationCursor struct {
+	CreatedAt time.Time
+	ID        int
+}
+
+// GetNotifications returns up to limit notifications of a user, newest first,
+// starting after the cursor when one is given
+func GetNotifications(ctx context.Context, db *sql.DB, userID int, cursor *NotificationCursor, limit int) (models.NotificationSlice, error) {
+	mods := []qm.QueryMod{
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("created_at DESC, id DESC"),
+		qm.Limit(limit),
+	}
+	if cursor != nil {
+		mods = append(mods, qm.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID))
+	}
+	return models.Notifications(mods...).All(ctx, db)
+}
+
+// CountUnreadNotifications returns the number of unread notifications of a user
+func CountUnreadNotifications(ctx context.Context, db *sql.DB, userID int) (int64, error) {
+	return models.Notifications(qm.Where("user_id = ? AND read_at IS NULL", userID)).Count(ctx, db)
+}
+
+// MarkNotificationRead marks a notification of a user as read.
+// It returns sql.ErrNoRows when the user has no such notification
+func MarkNotificationRead(ctx context.Context, db *sql.DB, userID int, id int, now time.Time) error {
+	notification, err := models.Notifications(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, db)
+	if err != nil {
+		return err
+	}
+	if notification.ReadAt.Valid {
+		return nil
+	}
+	notification.ReadAt = null.TimeFrom(now)
+	_, err = notification.Update(ctx, db, boil.Whitelist(models.NotificationColumns.ReadAt))
+	return err
+}
+
+// MarkAllNotificationsRead marks every notification of a user as read and returns how many were unread
+func MarkAllNotificationsRead(ctx context.Context, db *sql.DB, userID int, now time.Time) (int64, error) {
+	return models.Notifications(qm.Where("user_id = ? AND read_at IS NULL", userID)).UpdateAll(ctx, db, models.M{"read_at": now})
+}
+
+// GetNotificationPreferences returns whether each notification type is on for a user
+func GetNotificationPreferences(ctx context.Context, db *sql.DB, userID int) (map[string]bool, error) {
+	prefs := map[string]bool{}
+	for _, t := range NotificationTypes {
+		prefs[t] = true
+	}
+
+	stored, err := models.NotificationPreferences(qm.Where("user_id = ?", userID)).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range stored {
+		prefs[p.Type] = p.Enabled
+	}
+	return prefs, nil
+}
+
+// SetNotificationPreferences turns notification types on or off for a user
+func SetNotificationPreferences(ctx context.Context, db *sql.DB, userID int, prefs map[string]bool) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for t, enabled := range prefs {
+		pref := &models.NotificationPreference{UserID: userID, Type: t, Enabled: enabled}
+		if err := pref.Upsert(ctx, tx, true, []string{"user_id", "type"}, boil.Whitelist("enabled"), boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// notify records an event for a user. While a notification with the same group key is unread,
+// the event is aggregated into it instead, and a user already aggregated into it is not counted again.
+// Users are not notified of their own actions or of types they turned off
+func notify(ctx context.Context, exec boil.ContextExecutor, n *Notification) error {
+	if n.ActorID.Valid && n.ActorID.Int == n.UserID {
+		return nil
+	}
+
+	pref, err := models.FindNotificationPreference(ctx, exec, n.UserID, n.Type)
+	if err == nil && !pref.Enabled {
+		return nil
+	} else if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	if n.ActorID.Valid {
+		var actor struct {
+			Counted bool `boil:"counted"`
+		}
+		err := queries.Raw(`
+			SELECT EXISTS (
+				SELECT 1 FROM notification_actors
+				JOIN notifications ON notifications.id = notification_actors.notification_id
+				WHERE notifications.user_id = $1 AND notifications.group_key = $2
+				AND notifications.read_at IS NULL AND notification_actors.actor_id = $3
+			) AS counted`, n.UserID, n.GroupKey, n.ActorID.Int).Bind(ctx, exec, &actor)
+		if err != nil {
+			return err
+		}
+		if actor.Counted {
+			return nil
+		}
+	}
+
+	var notification struct {
+		ID         int `boil:"id"`
+		ActorCount int `boil:"actor_count"`
+	}
+	err = queries.Raw(`
+		INSERT INTO notifications (user_id, type, post_id, group_key, actor_id, actor_name, body)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, group_key) WHERE read_at IS NULL DO UPDATE SET
+			actor_id = excluded.actor_id,
+			actor_name = excluded.actor_name,
+			actor_count = notifications.actor_count + 1,
+			body = excluded.body,
+			updated_at = now()
+		RETURNING id, actor_count`,
+		n.UserID, n.Type, n.PostID, n.GroupKey, n.ActorID, n.ActorName, truncate(n.Body, notificationBodyLength),
+	).Bind(ctx, exec, &notification)
+	if err != nil {
+		return err
+	}
+
+	if n.ActorID.Valid {
+		_, err = queries.Raw(`
+			INSERT INTO notification_actors (notification_id, actor_id) VALUES ($1, $2)
+			ON CONFLICT DO NOTHING`, notification.ID, n.ActorID.Int).ExecContext(ctx, exec)
+		if err != nil {
+			return err
+		}
+	}
+
+	return publishEvent(ctx, exec, stream.NotificationsTopic(n.UserID), stream.EventNotification, map[string]interface{}{
+		"id":          notification.ID,
+		"type":        n.Type,
+		"post_id":     n.PostID,
+		"actor_name":  n.ActorName,
+		"actor_count": notification.ActorCount,
+		"body":        truncate(n.Body, notificationBodyLength),
+	})
+}
+
+// notifyPostAuthor notifies the primary author of a post of an event on it
+func notifyPostAuthor(ctx context.Context, exec boil.ContextExecutor, post *models.Post, typ string, actorID null.Int, actorName string, body string) error {
+	author, err := userByUsername(ctx, exec, post.Author.String)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return notify(ctx, exec, &Notification{
+		UserID:    author.ID,
+		Type:      typ,
+		PostID:    null.IntFrom(post.ID),
+		GroupKey:  typ + ":post:" + strconv.Itoa(post.ID),
+		ActorID:   actorID,
+		ActorName: actorName,
+		Body:      body,
+	})
+}
+
+// notifyReply notifies the signed in commenter of a share link comment that it was replied to.
+// Replies in a thread are grouped together
+func notifyReply(ctx context.Context, exec boil.ContextExecutor, post *models.Post, parent *models.ShareLinkComment, reply *models.ShareLinkComment) error {
+	return notify(ctx, exec, &Notification{
+		UserID:    parent.UserID.Int,
+		Type:      NotificationReply,
+		PostID:    null.IntFrom(post.ID),
+		GroupKey:  NotificationReply + ":comment:" + strconv.Itoa(parent.ID),
+		ActorID:   reply.UserID,
+		ActorName: reply.Name,
+		Body:      reply.Body,
+	})
+}
+
+// isPostAuthor reports whether a user writes a post under their name
+func isPostAuthor(ctx context.Context, exec boil.ContextExecutor, post *models.Post, userID int) bool {
+	author, err := userByUsername(ctx, exec, post.Author.String)
+	return err == nil && author.ID == userID
+}
+
+// notifyPublished notifies the followers of the author of a post that it went live
+func notifyPublished(ctx context.Context, exec boil.ContextExecutor, post *models.Post) error {
+	author, err := userByUsername(ctx, exec, post.Author.String)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	follows, err := models.UserFollows(qm.Where("followee_id = ?", author.ID)).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, f := range follows {
+		err := notify(ctx, exec, &Notification{
+			UserID:    f.FollowerID,
+			Type:      NotificationPublished,
+			PostID:    null.IntFrom(post.ID),
+			GroupKey:  NotificationPublished + ":post:" + strconv.Itoa(post.ID),
+			ActorID:   null.IntFrom(author.ID),
+			ActorName: post.Author.String,
+			Body:      post.Title.String,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// userByUsername returns the user writing under a name
+func userByUsername(ctx context.Context, exec boil.ContextExecutor, username string) (*models.User, error) {
+	return models.Users(qm.Where("split_part(email, '@', 1) = ?", username), qm.OrderBy("id")).One(ctx, exec)
+}
+
+// truncate shortens s to at most n characters
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -3,138 +3,338 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"time"
 
-	"github.com/lib/pq"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/types"
 
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
 	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/summary"
 )
 
 // Post contains fields required in a post
 type Post struct {
-	Author   string
-	Doc      string
-	Comments string
-	Tags     []string
-	Likes    int
+	Author      string
+	Title       string
+	Doc         string
+	Blocks      *blocks.Document
+	Comments    string
+	Tags        []string
+	Likes       int
+	PublishAt   time.Time
+	MembersOnly null.Bool
 }
 
-// GetPosts returns all posts
-func GetPosts(ctx context.Context, db *sql.DB) (*models.PostSlice, error) {
-	posts, err := models.Posts().All(ctx, db)
+// ErrPostPublished is returned when the publish time of a post that has gone live is changed
+var ErrPostPublished = errors.New("Post already published.")
+
+// publishedOnly restricts a posts query to posts that have gone live
+var publishedOnly = qm.Where("published_at IS NOT NULL")
+
+// GetPostByID returns a post by its ID
+func GetPostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
+	post, err := models.Posts(append([]qm.QueryMod{qm.Where("id = ?", id)}, withCoAuthors...)...).One(ctx, db)
 	if err != nil {
 		return nil, err
 	}
-	return &posts, nil
+	return post, nil
 }
 
-// GetPostsByTags returns posts that have a tag listed in the tags array
-func GetPostsByTags(ctx context.Context, db *sql.DB, tags []string) (*models.PostSlice, error) {
-	posts, err := models.Posts(qm.Where("tags @> ?", pq.Array(tags))).All(ctx, db)
+// GetLikesForPost returns the like count for a post by the given ID
+func GetLikesForPost(ctx context.Context, db *sql.DB, id int64) (int, error) {
+	post, err := GetPostByID(ctx, db, id)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return &posts, err
+	return post.Likes.Int, err
 }
 
-func GetPostsByAuthor(ctx context.Context, db *sql.DB, author string) (*models.PostSlice, error) {
-	posts, err := models.Posts(qm.Where("author = ?", author)).All(ctx, db)
+// InsertPost inserts new post into db with given Post struct
+// and records it as the first revision of the post
+func InsertPost(ctx context.Context, db *sql.DB, p *Post) (*models.Post, error) {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
-	return &posts, err
-}
+	defer tx.Rollback()
 
-// GetPostsByTagsAndFilterByAuthor returns posts that have a tag listed in tags array
-// and are written by the author
-func GetPostsByTagsAndFilterByAuthor(ctx context.Context, db *sql.DB, tags []string, author string) (*models.PostSlice, error) {
-	posts, err := models.Posts(qm.Where("tags @> ? AND author = ?", pq.Array(tags), author)).All(ctx, db)
+	tags, err := resolveTags(ctx, tx, p.Tags)
 	if err != nil {
 		return nil, err
 	}
-	return &posts, err
-}
 
-// GetPostByID returns a post by its ID
-func GetPostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
-	post, err := models.Posts(qm.Where("id = ?", id)).One(ctx, db)
+	post := BindDataToPostModel(p)
+	post.Tags = tagSlugs(tags)
+	mentioned, err := resolveMentions(ctx, tx, post, p.Author)
 	if err != nil {
 		return nil, err
 	}
+	if err := post.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := linkTags(ctx, tx, post.ID, tags); err != nil {
+		return nil, err
+	}
+	if err := referenceUploads(ctx, tx, post); err != nil {
+		return nil, err
+	}
+	if err := recordMentions(ctx, tx, post, mentioned); err != nil {
+		return nil, err
+	}
+	if err := recordRevision(ctx, tx, post, p.Author); err != nil {
+		return nil, err
+	}
+	if post.PublishedAt.Valid {
+		if err := publishPost(ctx, tx, post, post.PublishedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return post, nil
 }
 
-// GetLikesForPost returns the like count for a post by the given ID
-func GetLikesForPost(ctx context.Context, db *sql.DB, id int64) (int, error) {
+// DeletePostByID moves the post with the given ID to the trash
+func DeletePostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
 	post, err := GetPostByID(ctx, db, id)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return post.Likes.Int, err
+	if _, err = post.Delete(ctx, db, false); err != nil {
+		return nil, err
+	}
+	return post, nil
 }
 
-// InsertPost inserts new post into db with given Post struct
-func InsertPost(ctx context.Context, db *sql.DB, p *Post) (*models.Post, error) {
-	post := BindDataToPostModel(p)
-	if err := post.Insert(ctx, db, boil.Infer()); err != nil {
+// GetDeletedPostByID returns a post in the trash by its ID
+func GetDeletedPostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
+	post, err := models.Posts(qm.WithDeleted(), qm.Where("id = ? AND deleted_at IS NOT NULL", id)).One(ctx, db)
+	if err != nil {
 		return nil, err
 	}
 	return post, nil
 }
 
-// DeletePostByID deletes the post by its ID
-func DeletePostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
-	post, err := GetPostByID(ctx, db, id)
+// GetDeletedPostsByAuthor returns the posts of the author that are in the trash
+func GetDeletedPostsByAuthor(ctx context.Context, db *sql.DB, author string) (*models.PostSlice, error) {
+	posts, err := models.Posts(append([]qm.QueryMod{
+		qm.WithDeleted(),
+		qm.Where("author = ? AND deleted_at IS NOT NULL", author),
+		qm.OrderBy("deleted_at DESC"),
+	}, withCoAuthors...)...).All(ctx, db)
 	if err != nil {
 		return nil, err
 	}
-	if _, err = post.Delete(ctx, db); err != nil {
+	return &posts, nil
+}
+
+// RestorePostByID takes the post with the given ID out of the trash
+func RestorePostByID(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
+	post, err := GetDeletedPostByID(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	post.DeletedAt = null.Time{}
+
+	if _, err := post.Update(ctx, db, boil.Infer()); err != nil {
 		return nil, err
 	}
 	return post, nil
 }
 
+// PurgeDeletedPosts permanently deletes the posts moved to the trash before the given time
+func PurgeDeletedPosts(ctx context.Context, db *sql.DB, before time.Time) (int64, error) {
+	return models.Posts(qm.WithDeleted(), qm.Where("deleted_at < ?", before)).DeleteAll(ctx, db, true)
+}
+
 // UpdatePost updates a post with the provided ID and Post struct
-func UpdatePost(ctx context.Context, db *sql.DB, id int64, p *Post) (*models.Post, error) {
-	post, err := GetPostByID(ctx, db, id)
+// and records the result as a new revision made by the editor
+func UpdatePost(ctx context.Context, db *sql.DB, id int64, p *Post, editor string) (*models.Post, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	post, err := lockPostByID(ctx, tx, id)
 	if err != nil {
 		return nil, err
 	}
 	updatePostModel(post, p)
+	if len(p.Tags) > 0 {
+		if err := tagPost(ctx, tx, post, p.Tags); err != nil {
+			return nil, err
+		}
+	}
 
-	if _, err := post.Update(ctx, db, boil.Infer()); err != nil {
+	if err := updatePostWithRevision(ctx, tx, post, editor); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// SchedulePost sets the time at which an unpublished post goes live
+func SchedulePost(ctx context.Context, db *sql.DB, id int64, publishAt time.Time) (*models.Post, error) {
+	return setPublishAt(ctx, db, id, null.TimeFrom(publishAt))
+}
+
+// UnschedulePost clears the publish time of a post, leaving it as a draft
+func UnschedulePost(ctx context.Context, db *sql.DB, id int64) (*models.Post, error) {
+	return setPublishAt(ctx, db, id, null.Time{})
+}
+
+// setPublishAt changes the publish time of a post under its row lock, so that it cannot
+// race the scheduler publishing the post. ErrPostPublished is returned once the post is live
+func setPublishAt(ctx context.Context, db *sql.DB, id int64, publishAt null.Time) (*models.Post, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	post, err := lockPostByID(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.PublishedAt.Valid {
+		return nil, ErrPostPublished
+	}
+
+	post.PublishAt = publishAt
+	if _, err := post.Update(ctx, tx, boil.Whitelist(models.PostColumns.PublishAt)); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 	return post, nil
 }
 
+// PublishDuePosts publishes up to limit scheduled posts whose publish time is at or before now.
+// The rows are locked with FOR UPDATE SKIP LOCKED so that several server instances
+// running the scheduler never publish the same post twice.
+func PublishDuePosts(ctx context.Context, db *sql.DB, now time.Time, limit int) (*models.PostSlice, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	posts, err := models.Posts(
+		qm.Where("published_at IS NULL AND publish_at <= ?", now),
+		qm.OrderBy("publish_at"),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, post := range posts {
+		if err := publishPost(ctx, tx, post, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// publishPost makes a post go live at now, notifying the followers of its author and the users
+// mentioned in it. Posts inserted live already have their publish time and are only announced
+func publishPost(ctx context.Context, exec boil.ContextExecutor, post *models.Post, now time.Time) error {
+	if !post.PublishedAt.Valid {
+		post.PublishedAt = null.TimeFrom(now)
+		if _, err := post.Update(ctx, exec, boil.Whitelist(models.PostColumns.PublishedAt)); err != nil {
+			return err
+		}
+	}
+	if err := notifyPublished(ctx, exec, post); err != nil {
+		return err
+	}
+	return notifyMentions(ctx, exec, post)
+}
+
 func updatePostModel(post *models.Post, p *Post) {
 	if p.Author != "" {
 		post.Author = null.StringFrom(p.Author)
 	}
+	if p.Title != "" {
+		post.Title = null.StringFrom(p.Title)
+	}
 	if p.Comments != "" {
 		post.Comments = null.StringFrom(p.Comments)
 	}
-	if p.Doc != "" {
-		post.Document = null.StringFrom(p.Doc)
-	}
-	if p.Likes > 0 {
-		post.Likes = null.IntFrom(p.Likes)
+	if p.Doc != "" || p.Blocks != nil {
+		setDocument(post, p.Doc, p.Blocks)
 	}
 	if len(p.Tags) > 0 {
 		post.Tags = types.StringArray(p.Tags)
 	}
+	if p.MembersOnly.Valid {
+		post.MembersOnly = p.MembersOnly.Bool
+	}
 }
 
+// BindDataToPostModel converts a Post into a post model.
+// Posts without a publish time are published right away.
 func BindDataToPostModel(p *Post) *models.Post {
-	return &models.Post{
-		Author:   null.StringFrom(p.Author),
-		Document: null.StringFrom(p.Doc),
-		Likes:    null.IntFrom(p.Likes),
-		Comments: null.StringFrom(p.Comments),
-		Tags:     types.StringArray(p.Tags),
+	post := &models.Post{
+		Author:      null.StringFrom(p.Author),
+		Title:       null.StringFrom(p.Title),
+		Likes:       null.IntFrom(p.Likes),
+		Comments:    null.StringFrom(p.Comments),
+		Tags:        types.StringArray(p.Tags),
+		MembersOnly: p.MembersOnly.Bool,
+	}
+
+	if p.PublishAt.IsZero() {
+		post.PublishedAt = null.TimeFrom(time.Now())
+	} else {
+		post.PublishAt = null.TimeFrom(p.PublishAt)
+	}
+	setDocument(post, p.Doc, p.Blocks)
+	return post
+}
+
+// setDocument stores both forms of the document, converting the markdown
+// from the blocks when they are given and the blocks from the markdown otherwise,
+// along with its summary
+func setDocument(post *models.Post, doc string, b *blocks.Document) {
+	if b != nil {
+		doc = blocks.ToMarkdown(b)
+	} else {
+		b = blocks.FromMarkdown(doc)
 	}
+	post.Document = null.StringFrom(doc)
+	// A validated block document always marshals
+	_ = post.Blocks.Marshal(b)
+	renderDocument(post)
+
+	s := summary.Summarize(b)
+	post.WordCount = null.IntFrom(s.WordCount)
+	post.ReadingTime = null.IntFrom(s.ReadingTime)
+	post.Excerpt = null.StringFrom(s.Excerpt)
+}
+
+// renderDocument stores the rendered HTML and table of contents
+// of the markdown document alongside it
+func renderDocument(post *models.Post) {
+	rendered := markdown.Render(post.Document.String)
+	post.DocumentHTML = null.StringFrom(rendered.HTML)
+	// A slice of headings always marshals
+	_ = post.TableOfContents.Marshal(rendered.TOC)
 }
@@ -0,0 +1,299 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// Roles of publication members. Owners manage members, editors review submissions
+// and edit the posts of the publication, writers submit their drafts
+const (
+	RoleOwner  = "owner"
+	RoleEditor = "editor"
+	RoleWriter = "writer"
+)
+
+// States of a submission
+const (
+	SubmissionPending  = "pending"
+	SubmissionApproved = "approved"
+	SubmissionRejected = "rejected"
+)
+
+// ErrPublicationExists is returned when a publication with the same slug exists
+var ErrPublicationExists = errors.New("Publication already exists.")
+
+// ErrLastOwner is returned when a change would leave a publication without an owner
+var ErrLastOwner = errors.New("A publication needs an owner.")
+
+// ErrNotDraft is returned when submitting a post that is already published
+var ErrNotDraft = errors.New("Only unpublished posts can be submitted.")
+
+// ErrAlreadySubmitted is returned when submitting a post that is in a publication or awaiting review
+var ErrAlreadySubmitted = errors.New("Post is already in a publication or awaiting review.")
+
+// ErrSubmissionReviewed is returned when reviewing a submission twice
+var ErrSubmissionReviewed = errors.New("Submission was already reviewed.")
+
+// PublicationMember is a member of a publication with their email
+type PublicationMember struct {
+	models.PublicationMember `boil:",bind"`
+	Email                    string `boil:"email"`
+}
+
+// CreatePublication creates a publication owned by a user. Its slug is derived from its name
+func CreatePublication(ctx context.Context, db *sql.DB, ownerID int, name string, description null.String) (*models.Publication, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	slug := Slugify(name)
+	exists, err := models.Publications(qm.Where("slug = ?", slug)).Exists(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrPublicationExists
+	}
+
+	publication := &models.Publication{Slug: slug, Name: name, Description: description}
+	if err := publication.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	owner := &models.PublicationMember{PublicationID: publication.ID, UserID: ownerID, Role: RoleOwner}
+	if err := owner.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return publication, nil
+}
+
+// GetPublicationBySlug returns a publication by its slug
+func GetPublicationBySlug(ctx context.Context, db *sql.DB, slug string) (*models.Publication, error) {
+	return models.Publications(qm.Where("slug = ?", Slugify(slug))).One(ctx, db)
+}
+
+// GetPublicationMembers returns the members of a publication, owners first
+func GetPublicationMembers(ctx context.Context, db *sql.DB, publicationID int) ([]*PublicationMember, error) {
+	members := []*PublicationMember{}
+	err := models.NewQuery(
+		qm.Select("publication_members.*", "users.email"),
+		qm.From("publication_members"),
+		qm.InnerJoin("users ON users.id = publication_members.user_id"),
+		qm.Where("publication_members.publication_id = ?", publicationID),
+		qm.OrderBy("array_position(ARRAY['owner', 'editor', 'writer']::varchar[], publication_members.role), users.email"),
+	).Bind(ctx, db, &members)
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// GetMemberRole returns the role of a user in a publication, or an empty string for non-members
+func GetMemberRole(ctx context.Context, db *sql.DB, publicationID int, userID int) (string, error) {
+	member, err := models.FindPublicationMember(ctx, db, publicationID, userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return member.Role, nil
+}
+
+// SetPublicationMember adds a user to a publication or changes their role
+func SetPublicationMember(ctx context.Context, db *sql.DB, publicationID int, userID int, role string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if role != RoleOwner {
+		if err := checkOtherOwners(ctx, tx, publicationID, userID); err != nil {
+			return err
+		}
+	}
+
+	member := &models.PublicationMember{PublicationID: publicationID, UserID: userID, Role: role}
+	if err := member.Upsert(ctx, tx, true, []string{"publication_id", "user_id"}, boil.Whitelist("role"), boil.Infer()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemovePublicationMember removes a user from a publication
+func RemovePublicationMember(ctx context.Context, db *sql.DB, publicationID int, userID int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := checkOtherOwners(ctx, tx, publicationID, userID); err != nil {
+		return err
+	}
+	if _, err := models.PublicationMembers(qm.Where("publication_id = ? AND user_id = ?", publicationID, userID)).DeleteAll(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetPublicationPosts returns a page of the published posts of a publication, newest first
+func GetPublicationPosts(ctx context.Context, db *sql.DB, publicationID int, limit int, offset int) (*models.PostSlice, error) {
+	posts, err := models.Posts(append([]qm.QueryMod{
+		publishedOnly,
+		qm.Where("publication_id = ?", publicationID),
+		qm.OrderBy("published_at DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// SubmitPost puts an unpublished post in the review queue of a publication
+func SubmitPost(ctx context.Context, db *sql.DB, publicationID int, post *models.Post, userID int) (*models.Submission, error) {
+	if post.PublishedAt.Valid {
+		return nil, ErrNotDraft
+	}
+
+	pending, err := models.Submissions(qm.Where("post_id = ? AND status = ?", post.ID, SubmissionPending)).Exists(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if pending || post.PublicationID.Valid {
+		return nil, ErrAlreadySubmitted
+	}
+
+	submission := &models.Submission{
+		PublicationID: publicationID,
+		PostID:        post.ID,
+		SubmittedBy:   userID,
+		Status:        SubmissionPending,
+	}
+	if err := submission.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return submission, nil
+}
+
+// GetSubmissions returns the submissions to a publication in a state, oldest first,
+// with their posts. A non-zero submittedBy limits them to those of one user
+func GetSubmissions(ctx context.Context, db *sql.DB, publicationID int, status string, submittedBy int) (*models.SubmissionSlice, error) {
+	mods := []qm.QueryMod{
+		qm.Where("publication_id = ? AND status = ?", publicationID, status),
+		qm.OrderBy("created_at, id"),
+		qm.Load(models.SubmissionRels.Post),
+	}
+	mods = append(mods, withCoAuthorsOf(models.SubmissionRels.Post)...)
+	if submittedBy != 0 {
+		mods = append(mods, qm.Where("submitted_by = ?", submittedBy))
+	}
+
+	submissions, err := models.Submissions(mods...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &submissions, nil
+}
+
+// GetSubmission returns a submission to a publication by its ID
+func GetSubmission(ctx context.Context, db *sql.DB, publicationID int, id int64) (*models.Submission, error) {
+	return models.Submissions(qm.Where("id = ? AND publication_id = ?", id, publicationID)).One(ctx, db)
+}
+
+// ReviewSubmission approves or rejects a pending submission with a note for the writer.
+// Approved posts join the publication and are published right away unless they are scheduled
+func ReviewSubmission(ctx context.Context, db *sql.DB, submission *models.Submission, reviewerID int, approve bool, note null.String, now time.Time) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := submission.Reload(ctx, tx); err != nil {
+		return err
+	}
+	if submission.Status != SubmissionPending {
+		return ErrSubmissionReviewed
+	}
+
+	submission.Status = SubmissionRejected
+	if approve {
+		submission.Status = SubmissionApproved
+
+		post, err := models.FindPost(ctx, tx, submission.PostID)
+		if err != nil {
+			return err
+		}
+		post.PublicationID = null.IntFrom(submission.PublicationID)
+		if _, err := post.Update(ctx, tx, boil.Whitelist("publication_id")); err != nil {
+			return err
+		}
+		if !post.PublishAt.Valid && !post.PublishedAt.Valid {
+			if err := publishPost(ctx, tx, post, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	submission.Note = note
+	submission.ReviewedBy = null.IntFrom(reviewerID)
+	submission.ReviewedAt = null.TimeFrom(now)
+	if _, err := submission.Update(ctx, tx, boil.Whitelist("status", "note", "reviewed_by", "reviewed_at")); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CanEditPost reports whether a user co-authors a post or edits the publication it belongs to
+func CanEditPost(ctx context.Context, db *sql.DB, post *models.Post, userID int) (bool, error) {
+	coAuthor, err := isCoAuthor(ctx, db, post.ID, userID)
+	if err != nil || coAuthor || !post.PublicationID.Valid {
+		return coAuthor, err
+	}
+	role, err := GetMemberRole(ctx, db, post.PublicationID.Int, userID)
+	if err != nil {
+		return false, err
+	}
+	return role == RoleOwner || role == RoleEditor, nil
+}
+
+// checkOtherOwners returns ErrLastOwner when the user is the only owner of a publication
+func checkOtherOwners(ctx context.Context, exec boil.ContextExecutor, publicationID int, userID int) error {
+	member, err := models.PublicationMembers(qm.Where("publication_id = ? AND user_id = ?", publicationID, userID)).One(ctx, exec)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if member.Role != RoleOwner {
+		return nil
+	}
+
+	owners, err := models.PublicationMembers(
+		qm.Where("publication_id = ? AND role = ? AND user_id <> ?", publicationID, RoleOwner, userID),
+	).Count(ctx, exec)
+	if err != nil {
+		return err
+	}
+	if owners == 0 {
+		return ErrLastOwner
+	}
+	return nil
+}
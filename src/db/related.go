@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// MaxRelatedPosts is the number of related posts kept for a post
+const MaxRelatedPosts = 20
+
+// RelatedPostsTTL is how long related posts are cached.
+// Tag changes drop the cache right away; likes and new posts show up once it expires
+const RelatedPostsTTL = time.Hour
+
+// Weights of the signals relating two posts
+const (
+	sharedTagWeight  = 3.0
+	sameAuthorWeight = 2.0
+	coLikeWeight     = 1.0
+	titleWeight      = 4.0
+)
+
+type relatedMatch struct {
+	ID int `boil:"id"`
+}
+
+// GetRelatedPosts returns up to limit published posts related to a post, best first.
+// Related posts are computed when the cache of the post is missing or older than RelatedPostsTTL
+func GetRelatedPosts(ctx context.Context, db *sql.DB, postID int, limit int, now time.Time) (*models.PostSlice, error) {
+	related, err := models.FindRelatedPost(ctx, db, postID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if related == nil || related.ComputedAt.Before(now.Add(-RelatedPostsTTL)) {
+		if related, err = computeRelatedPosts(ctx, db, postID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]interface{}, len(related.RelatedIds))
+	for i, id := range related.RelatedIds {
+		ids[i] = int(id)
+	}
+	if len(ids) == 0 {
+		return &models.PostSlice{}, nil
+	}
+	posts, err := models.Posts(append([]qm.QueryMod{publishedOnly, qm.WhereIn("id IN ?", ids...)}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	postsByID := make(map[int]*models.Post, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+
+	// Posts unpublished or deleted since the cache was computed are left out
+	ordered := models.PostSlice{}
+	for _, id := range related.RelatedIds {
+		if post, ok := postsByID[int(id)]; ok && len(ordered) < limit {
+			ordered = append(ordered, post)
+		}
+	}
+	return &ordered, nil
+}
+
+// computeRelatedPosts scores every published post against a post and caches the best ones.
+// A score adds up weighted shared tags, a shared author, readers who liked both posts
+// and the trigram similarity of the titles
+func computeRelatedPosts(ctx context.Context, db *sql.DB, postID int, now time.Time) (*models.RelatedPost, error) {
+	var matches []*relatedMatch
+	err := queries.Raw(`
+		SELECT id FROM (
+			SELECT posts.id, posts.published_at,
+				$2::float8 * (
+					SELECT count(*) FROM post_tags
+					JOIN post_tags own ON own.tag_id = post_tags.tag_id AND own.post_id = source.id
+					WHERE post_tags.post_id = posts.id)
+				+ CASE WHEN posts.author = source.author THEN $3::float8 ELSE 0 END
+				+ $4::float8 * (
+					SELECT count(*) FROM post_likes
+					JOIN post_likes own ON own.user_id = post_likes.user_id AND own.post_id = source.id
+					WHERE post_likes.post_id = posts.id)
+				+ $5::float8 * similarity(coalesce(posts.title, ''), coalesce(source.title, '')) AS score
+			FROM posts, posts source
+			WHERE source.id = $1 AND posts.id <> source.id
+				AND posts.deleted_at IS NULL AND posts.published_at IS NOT NULL
+		) scored
+		WHERE score > 0
+		ORDER BY score DESC, published_at DESC
+		LIMIT $6`,
+		postID, sharedTagWeight, sameAuthorWeight, coLikeWeight, titleWeight, MaxRelatedPosts,
+	).Bind(ctx, db, &matches)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(types.Int64Array, len(matches))
+	for i, m := range matches {
+		ids[i] = int64(m.ID)
+	}
+	related := &models.RelatedPost{PostID: postID, RelatedIds: ids, ComputedAt: now}
+	if err := related.Upsert(ctx, db, true, []string{"post_id"}, boil.Whitelist("related_ids", "computed_at"), boil.Infer()); err != nil {
+		return nil, err
+	}
+	return related, nil
+}
+
+// invalidateRelatedPosts drops the cached related posts a change to the tags of a post can affect:
+// its own, those listing it and those of posts it now shares a tag with
+func invalidateRelatedPosts(ctx context.Context, exec boil.ContextExecutor, postID int) error {
+	_, err := queries.Raw(`
+		DELETE FROM related_posts
+		WHERE post_id = $1 OR related_ids @> ARRAY[$1]::integer[] OR post_id IN (
+			SELECT post_tags.post_id FROM post_tags
+			JOIN post_tags own ON own.tag_id = post_tags.tag_id
+			WHERE own.post_id = $1
+		)`, postID).ExecContext(ctx, exec)
+	return err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// GetRevisions returns every revision of a post, oldest first
+func GetRevisions(ctx context.Context, db *sql.DB, postID int64) (*models.PostRevisionSlice, error) {
+	revisions, err := models.PostRevisions(
+		qm.Where("post_id = ?", postID),
+		qm.OrderBy("revision"),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &revisions, nil
+}
+
+// GetRevision returns a revision of a post by its revision number
+func GetRevision(ctx context.Context, db *sql.DB, postID int64, rev int) (*models.PostRevision, error) {
+	revision, err := models.PostRevisions(qm.Where("post_id = ? AND revision = ?", postID, rev)).One(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// RestoreRevision brings a post back to the content of an earlier revision.
+// The restored content is recorded as a new revision, keeping the history intact
+func RestoreRevision(ctx context.Context, db *sql.DB, postID int64, rev int, editor string) (*models.Post, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	post, err := lockPostByID(ctx, tx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := models.PostRevisions(qm.Where("post_id = ? AND revision = ?", postID, rev)).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	post.Title = revision.Title
+	setDocument(post, revision.Document.String, nil)
+	if err := tagPost(ctx, tx, post, revision.Tags); err != nil {
+		return nil, err
+	}
+
+	if err := updatePostWithRevision(ctx, tx, post, editor); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// lockPostByID returns a post by its ID and locks it until the transaction ends,
+// so concurrent edits are numbered one after another
+func lockPostByID(ctx context.Context, tx *sql.Tx, id int64) (*models.Post, error) {
+	post, err := models.Posts(append([]qm.QueryMod{qm.Where("id = ?", id), qm.For("UPDATE")}, withCoAuthors...)...).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+func updatePostWithRevision(ctx context.Context, tx *sql.Tx, post *models.Post, editor string) error {
+	mentioned, err := resolveMentions(ctx, tx, post, editor)
+	if err != nil {
+		return err
+	}
+	if _, err := post.Update(ctx, tx, boil.Infer()); err != nil {
+		return err
+	}
+	if err := referenceUploads(ctx, tx, post); err != nil {
+		return err
+	}
+	if err := recordMentions(ctx, tx, post, mentioned); err != nil {
+		return err
+	}
+	return recordRevision(ctx, tx, post, editor)
+}
+
+func recordRevision(ctx context.Context, exec boil.ContextExecutor, post *models.Post, editor string) error {
+	latest, err := models.PostRevisions(
+		qm.Where("post_id = ?", post.ID),
+		qm.OrderBy("revision DESC"),
+	).One(ctx, exec)
+
+	next := 1
+	if err == nil {
+		next = latest.Revision + 1
+	} else if err != sql.ErrNoRows {
+		return err
+	}
+
+	revision := &models.PostRevision{
+		PostID:   post.ID,
+		Revision: next,
+		Title:    post.Title,
+		Document: post.Document,
+		Tags:     post.Tags,
+		Editor:   null.StringFrom(editor),
+	}
+	return revision.Insert(ctx, exec, boil.Infer())
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// Private use characters mark the matches in a snippet until it is escaped
+const (
+	highlightStart = "\ue000"
+	highlightStop  = "\ue001"
+)
+
+var headlineOptions = fmt.Sprintf(
+	`StartSel="%s", StopSel="%s", MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=" … "`,
+	highlightStart, highlightStop,
+)
+
+// Search contains the query and filters of a post search
+type Search struct {
+	Query string
+	// Filter narrows down the matches like lists of posts. Its status is ignored,
+	// only published posts are searched
+	Filter *PostFilter
+	Limit  int
+	Offset int
+}
+
+// SearchResult is a post matching a search with its rank
+// and an HTML snippet in which the matches are wrapped in <mark>
+type SearchResult struct {
+	Post    *models.Post
+	Rank    float32
+	Snippet string
+}
+
+type searchMatch struct {
+	ID          int     `boil:"id"`
+	Rank        float32 `boil:"rank"`
+	Snippet     string  `boil:"snippet"`
+	MembersOnly bool    `boil:"members_only"`
+	TotalCount  int64   `boil:"total_count"`
+}
+
+// SearchPosts returns a page of published posts matching a web search style query,
+// best matches first, and the total number of matches
+func SearchPosts(ctx context.Context, db *sql.DB, s *Search) ([]*SearchResult, int64, error) {
+	args := []interface{}{s.Query, headlineOptions}
+	conditions := []string{
+		"search_vector @@ query",
+		"deleted_at IS NULL",
+		"published_at IS NOT NULL",
+	}
+	if s.Filter != nil {
+		if err := s.Filter.resolveTagAliases(ctx, db); err != nil {
+			return nil, 0, err
+		}
+		for _, cond := range s.Filter.conditions() {
+			args = append(args, cond.arg)
+			conditions = append(conditions, strings.Replace(cond.clause, "?", fmt.Sprintf("$%d", len(args)), 1))
+		}
+	}
+	args = append(args, s.Limit, s.Offset)
+
+	var matches []*searchMatch
+	err := queries.Raw(fmt.Sprintf(`
+		SELECT id,
+			ts_rank(search_vector, query) AS rank,
+			ts_headline('english', coalesce(document, ''), query, $2) AS snippet,
+			members_only,
+			count(*) OVER () AS total_count
+		FROM posts, websearch_to_tsquery('english', $1) query
+		WHERE %s
+		ORDER BY rank DESC, published_at DESC
+		LIMIT $%d OFFSET $%d`,
+		strings.Join(conditions, " AND "), len(args)-1, len(args),
+	), args...).Bind(ctx, db, &matches)
+	if err != nil || len(matches) == 0 {
+		return []*SearchResult{}, 0, err
+	}
+
+	ids := make([]interface{}, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	posts, err := models.Posts(append([]qm.QueryMod{qm.WhereIn("id IN ?", ids...)}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	postsByID := make(map[int]*models.Post, len(posts))
+	for _, post := range posts {
+		postsByID[post.ID] = post
+	}
+
+	results := make([]*SearchResult, 0, len(matches))
+	for _, m := range matches {
+		// A post deleted between the two queries is left out
+		post, ok := postsByID[m.ID]
+		if !ok {
+			continue
+		}
+		// The snippet of a members-only post is taken from the preview shown behind the paywall
+		if m.MembersOnly {
+			if m.Snippet, err = previewHeadline(ctx, db, post, s.Query); err != nil {
+				return nil, 0, err
+			}
+		}
+		results = append(results, &SearchResult{post, m.Rank, highlight(m.Snippet)})
+	}
+	return results, matches[0].TotalCount, nil
+}
+
+// previewHeadline returns the snippet of the matches of a query in the paywall preview of a post
+func previewHeadline(ctx context.Context, db *sql.DB, post *models.Post, query string) (string, error) {
+	var headline struct {
+		Snippet string `boil:"snippet"`
+	}
+	err := queries.Raw(
+		`SELECT ts_headline('english', $1, websearch_to_tsquery('english', $2), $3) AS snippet`,
+		PreviewDocument(post.Document.String), query, headlineOptions,
+	).Bind(ctx, db, &headline)
+	return headline.Snippet, err
+}
+
+// highlight escapes a snippet and turns the match markers into <mark> elements
+func highlight(snippet string) string {
+	return strings.NewReplacer(
+		highlightStart, "<mark>",
+		highlightStop, "</mark>",
+	).Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// ErrPostInSeries is returned when adding a post that already belongs to a series
+var ErrPostInSeries = errors.New("Post already belongs to a series.")
+
+// SeriesPart is where a post stands in its series
+type SeriesPart struct {
+	Series   *models.Series
+	Part     int
+	Parts    int
+	Previous *models.Post
+	Next     *models.Post
+}
+
+// CreateSeries creates an empty series for an author
+func CreateSeries(ctx context.Context, db *sql.DB, author string, title string, description null.String) (*models.Series, error) {
+	series := &models.Series{Author: author, Title: title, Description: description}
+	if err := series.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// GetSeriesByID returns a series by its ID
+func GetSeriesByID(ctx context.Context, db *sql.DB, id int64) (*models.Series, error) {
+	return models.AllSeries(qm.Where("id = ?", id)).One(ctx, db)
+}
+
+// GetSeriesPosts returns the posts of a series in order.
+// Unpublished parts are left out unless includeUnpublished is set
+func GetSeriesPosts(ctx context.Context, db *sql.DB, seriesID int, includeUnpublished bool) (*models.PostSlice, error) {
+	mods := []qm.QueryMod{
+		qm.InnerJoin("series_posts ON series_posts.post_id = posts.id"),
+		qm.Where("series_posts.series_id = ?", seriesID),
+		qm.OrderBy("series_posts.position"),
+	}
+	if !includeUnpublished {
+		mods = append(mods, publishedOnly)
+	}
+
+	posts, err := models.Posts(append(mods, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// AddPostToSeries appends a post to a series
+func AddPostToSeries(ctx context.Context, db *sql.DB, seriesID int, postID int) error {
+	exists, err := models.SeriesPosts(qm.Where("post_id = ?", postID)).Exists(ctx, db)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrPostInSeries
+	}
+
+	_, err = queries.Raw(`
+		INSERT INTO series_posts (series_id, post_id, position)
+		SELECT $1, $2, coalesce(max(position) + 1, 0) FROM series_posts WHERE series_id = $1`,
+		seriesID, postID).ExecContext(ctx, db)
+	return err
+}
+
+// RemovePostFromSeries removes a post from a series
+func RemovePostFromSeries(ctx context.Context, db *sql.DB, seriesID int, postID int) error {
+	_, err := models.SeriesPosts(qm.Where("series_id = ? AND post_id = ?", seriesID, postID)).DeleteAll(ctx, db)
+	return err
+}
+
+// ReorderSeries puts the posts of a series in the given order,
+// which must contain every post in the series exactly once
+func ReorderSeries(ctx context.Context, db *sql.DB, seriesID int, postIDs []int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	entries, err := models.SeriesPosts(qm.Where("series_id = ?", seriesID), qm.For("UPDATE")).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[int]int, len(postIDs))
+	for i, id := range postIDs {
+		positions[id] = i
+	}
+	if len(positions) != len(postIDs) || len(positions) != len(entries) {
+		return ErrInvalidOrder
+	}
+
+	for _, entry := range entries {
+		position, ok := positions[entry.PostID]
+		if !ok {
+			return ErrInvalidOrder
+		}
+		entry.Position = position
+		if _, err := entry.Update(ctx, tx, boil.Whitelist("position")); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetSeriesPart returns the series a post belongs to with its neighbours among the published parts.
+// An unpublished post still counts as a part so that its author can preview the navigation
+func GetSeriesPart(ctx context.Context, db *sql.DB, post *models.Post) (*SeriesPart, error) {
+	entry, err := models.SeriesPosts(qm.Where("post_id = ?", post.ID), qm.Load(models.SeriesPostRels.Series)).One(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := models.Posts(
+		qm.InnerJoin("series_posts ON series_posts.post_id = posts.id"),
+		qm.Where("series_posts.series_id = ?", entry.SeriesID),
+		qm.Where("(posts.published_at IS NOT NULL OR posts.id = ?)", post.ID),
+		qm.OrderBy("series_posts.position"),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	part := &SeriesPart{Series: entry.R.Series, Parts: len(parts)}
+	for i, p := range parts {
+		if p.ID != post.ID {
+			continue
+		}
+		part.Part = i + 1
+		if i > 0 {
+			part.Previous = parts[i-1]
+		}
+		if i < len(parts)-1 {
+			part.Next = parts[i+1]
+		}
+	}
+	return part, nil
+}
@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// shareTokenBytes is the amount of randomness in a share token
+const shareTokenBytes = 32
+
+// ErrLinkExpired is returned when opening a share link after its expiry
+var ErrLinkExpired = errors.New("Share link expired.")
+
+// ErrCommentNotFound is returned when replying to a comment that is not on the same share link
+var ErrCommentNotFound = errors.New("Comment not found.")
+
+// ShareLinkStats is a share link with a summary of its access log
+type ShareLinkStats struct {
+	models.ShareLink `boil:",bind"`
+	AccessCount      int       `boil:"access_count"`
+	LastAccessedAt   null.Time `boil:"last_accessed_at"`
+}
+
+// CreateShareLink creates a secret link to a post and returns it with its token.
+// Only a hash of the token is stored, so the token cannot be shown again
+func CreateShareLink(ctx context.Context, db *sql.DB, postID int, allowComments bool, expiresAt null.Time) (*models.ShareLink, string, error) {
+	raw := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	link := &models.ShareLink{
+		PostID:        postID,
+		TokenHash:     hashShareToken(token),
+		AllowComments: allowComments,
+		ExpiresAt:     expiresAt,
+	}
+	if err := link.Insert(ctx, db, boil.Infer()); err != nil {
+		return nil, "", err
+	}
+	return link, token, nil
+}
+
+// GetShareLinks returns the share links of a post, newest first, with their access counts
+func GetShareLinks(ctx context.Context, db *sql.DB, postID int) ([]*ShareLinkStats, error) {
+	links := []*ShareLinkStats{}
+	err := models.NewQuery(
+		qm.Select("share_links.*", "count(share_link_accesses.id) AS access_count", "max(share_link_accesses.accessed_at) AS last_accessed_at"),
+		qm.From("share_links"),
+		qm.LeftOuterJoin("share_link_accesses ON share_link_accesses.link_id = share_links.id"),
+		qm.Where("share_links.post_id = ?", postID),
+		qm.GroupBy("share_links.id"),
+		qm.OrderBy("share_links.created_at DESC, share_links.id DESC"),
+	).Bind(ctx, db, &links)
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// GetShareLink returns a share link of a post by its ID
+func GetShareLink(ctx context.Context, db *sql.DB, postID int, id int64) (*models.ShareLink, error) {
+	return models.ShareLinks(qm.Where("id = ? AND post_id = ?", id, postID)).One(ctx, db)
+}
+
+// GetShareLinkAccesses returns the access log of a share link, newest first
+func GetShareLinkAccesses(ctx context.Context, db *sql.DB, linkID int) (models.ShareLinkAccessSlice, error) {
+	return models.ShareLinkAccesses(qm.Where("link_id = ?", linkID), qm.OrderBy("accessed_at DESC, id DESC")).All(ctx, db)
+}
+
+// GetShareLinkComments returns the comments left through a share link, oldest first,
+// with the users mentioned in them
+func GetShareLinkComments(ctx context.Context, db *sql.DB, linkID int) (models.ShareLinkCommentSlice, error) {
+	return models.ShareLinkComments(
+		qm.Where("link_id = ?", linkID),
+		qm.OrderBy("created_at, id"),
+		qm.Load(models.ShareLinkCommentRels.CommentCommentMentions),
+	).All(ctx, db)
+}
+
+// RevokeShareLink stops a share link from opening its post
+func RevokeShareLink(ctx context.Context, db *sql.DB, link *models.ShareLink, now time.Time) error {
+	if link.RevokedAt.Valid {
+		return nil
+	}
+	link.RevokedAt = null.TimeFrom(now)
+	_, err := link.Update(ctx, db, boil.Whitelist("revoked_at"))
+	return err
+}
+
+// FindShareLink returns the share link with a token along with its post.
+// Revoked links are not found, expired ones return ErrLinkExpired
+func FindShareLink(ctx context.Context, db *sql.DB, token string, now time.Time) (*models.ShareLink, error) {
+	link, err := models.ShareLinks(
+		qm.Where("token_hash = ? AND revoked_at IS NULL", hashShareToken(token)),
+		qm.Load(models.ShareLinkRels.Post),
+	).One(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	// A deleted post is not loaded
+	if link.R.Post == nil {
+		return nil, sql.ErrNoRows
+	}
+	if link.ExpiresAt.Valid && !link.ExpiresAt.Time.After(now) {
+		return nil, ErrLinkExpired
+	}
+	return link, nil
+}
+
+// RecordShareLinkAccess adds an entry to the access log of a share link
+func RecordShareLinkAccess(ctx context.Context, db *sql.DB, linkID int, ip string, userAgent string, now time.Time) error {
+	access := &models.ShareLinkAccess{
+		LinkID:     linkID,
+		IP:         null.NewString(ip, ip != ""),
+		UserAgent:  null.NewString(userAgent, userAgent != ""),
+		AccessedAt: now,
+	}
+	return access.Insert(ctx, db, boil.Infer())
+}
+
+// AddShareLinkComment records the feedback of a reviewer on the post of a share link,
+// in reply to another comment of the link when parentID is set. The commenter is set
+// when they are signed in. The author of the post, the commenter replied to and the
+// authors mentioned are notified, and the comment is streamed to the subscribers of
+// the comments of the post
+func AddShareLinkComment(ctx context.Context, db *sql.DB, linkID int, userID null.Int, parentID null.Int, name string, body string) (*models.ShareLinkComment, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var parent *models.ShareLinkComment
+	if parentID.Valid {
+		parent, err = models.ShareLinkComments(qm.Where("id = ? AND link_id = ?", parentID.Int, linkID)).One(ctx, tx)
+		if err == sql.ErrNoRows {
+			return nil, ErrCommentNotFound
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	comment := &models.ShareLinkComment{LinkID: linkID, Name: name, Body: body, ParentID: parentID, UserID: userID}
+	if err := comment.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	post, err := models.Posts(
+		qm.InnerJoin("share_links ON share_links.post_id = posts.id"),
+		qm.Where("share_links.id = ?", linkID),
+	).One(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if parent != nil && parent.UserID.Valid {
+		if err := notifyReply(ctx, tx, post, parent, comment); err != nil {
+			return nil, err
+		}
+	}
+	// An author answered in a thread is notified of the reply only
+	if parent == nil || !parent.UserID.Valid || !isPostAuthor(ctx, tx, post, parent.UserID.Int) {
+		if err := notifyPostAuthor(ctx, tx, post, NotificationComment, userID, name, body); err != nil {
+			return nil, err
+		}
+	}
+	if err := mentionInComment(ctx, tx, post, comment); err != nil {
+		return nil, err
+	}
+	err = publishEvent(ctx, tx, stream.PostCommentsTopic(post.ID), stream.EventComment, map[string]interface{}{
+		"id":         comment.ID,
+		"post_id":    post.ID,
+		"parent_id":  comment.ParentID,
+		"name":       comment.Name,
+		"body":       truncate(comment.Body, eventBodyLength),
+		"created_at": comment.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
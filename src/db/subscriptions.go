@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// PreviewBlocks is the number of leading blocks of a members-only post shown behind the paywall
+const PreviewBlocks = 3
+
+// Subscription tiers. Every tier gives access to members-only posts
+const (
+	TierMember = "member"
+	TierPatron = "patron"
+)
+
+// States of a subscription
+const (
+	SubscriptionActive   = "active"
+	SubscriptionCanceled = "canceled"
+)
+
+// MeteredRead tells whether a reader may read a members-only post for free
+// and how many free reads they have left this month
+type MeteredRead struct {
+	Allowed   bool
+	Remaining int
+}
+
+// GetSubscription returns the subscription of a user, valid or not
+func GetSubscription(ctx context.Context, db *sql.DB, userID int) (*models.Subscription, error) {
+	return models.Subscriptions(qm.Where("user_id = ?", userID)).One(ctx, db)
+}
+
+// HasValidSubscription reports whether a user paid for the current time. A canceled
+// subscription remains valid until the end of its period
+func HasValidSubscription(ctx context.Context, db *sql.DB, userID int, now time.Time) (bool, error) {
+	return models.Subscriptions(qm.Where("user_id = ? AND current_period_end > ?", userID, now)).Exists(ctx, db)
+}
+
+// SaveSubscription records the subscription a user took out with the payment provider,
+// replacing an expired one
+func SaveSubscription(ctx context.Context, db *sql.DB, userID int, tier string, period string, providerID string, periodEnd time.Time) (*models.Subscription, error) {
+	subscription := &models.Subscription{
+		UserID:           userID,
+		Tier:             tier,
+		Period:           period,
+		Status:           SubscriptionActive,
+		ProviderID:       providerID,
+		CurrentPeriodEnd: periodEnd,
+	}
+	err := subscription.Upsert(ctx, db, true, []string{"user_id"},
+		boil.Whitelist("tier", "period", "status", "provider_id", "current_period_end", "canceled_at"),
+		boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// CancelSubscription stops the renewal of a subscription
+func CancelSubscription(ctx context.Context, db *sql.DB, subscription *models.Subscription, now time.Time) error {
+	subscription.Status = SubscriptionCanceled
+	subscription.CanceledAt = null.TimeFrom(now)
+	_, err := subscription.Update(ctx, db, boil.Whitelist("status", "canceled_at"))
+	return err
+}
+
+// ReadMeteredPost counts a members-only post against the free allowance of a reader
+// for the month of now. Reading the same post again in a month is not counted twice
+func ReadMeteredPost(ctx context.Context, db *sql.DB, userID int, postID int, allowance int, now time.Time) (*MeteredRead, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Concurrent reads of one reader are counted one after another
+	if _, err := models.Users(qm.Where("id = ?", userID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	now = now.UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	reads, err := models.MeteredReads(qm.Where("user_id = ? AND month = ?", userID, month)).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	read := &MeteredRead{Remaining: allowance - len(reads)}
+	for _, r := range reads {
+		if r.PostID == postID {
+			read.Allowed = true
+			return read, tx.Commit()
+		}
+	}
+	if read.Remaining <= 0 {
+		read.Remaining = 0
+		return read, nil
+	}
+
+	meter := &models.MeteredRead{UserID: userID, PostID: postID, Month: month}
+	if err := meter.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	read.Allowed = true
+	read.Remaining--
+	return read, tx.Commit()
+}
+
+// PreviewDocument returns the leading blocks of a markdown document that are shown behind the paywall
+func PreviewDocument(doc string) string {
+	b := blocks.FromMarkdown(doc)
+	if len(b.Blocks) > PreviewBlocks {
+		b.Blocks = b.Blocks[:PreviewBlocks]
+	}
+	return blocks.ToMarkdown(b)
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Types of search suggestions
+const (
+	SuggestionTag    = "tag"
+	SuggestionAuthor = "author"
+	SuggestionTitle  = "title"
+)
+
+// Suggestion is a tag, author handle or post title resembling a partial search query.
+// Score is the trigram similarity between the two, from 0 to 1
+type Suggestion struct {
+	Type   string   `boil:"type"`
+	Text   string   `boil:"text"`
+	PostID null.Int `boil:"post_id"`
+	Score  float32  `boil:"score"`
+}
+
+// SuggestTags returns the tags of published posts resembling the query, best first
+func SuggestTags(ctx context.Context, db *sql.DB, query string, limit int) ([]*Suggestion, error) {
+	return suggest(ctx, db, fmt.Sprintf(`
+		SELECT 'tag' AS type, tag AS text, NULL::integer AS post_id, %s AS score
+		FROM (
+			SELECT DISTINCT unnest(tags) AS tag FROM posts
+			WHERE deleted_at IS NULL AND published_at IS NOT NULL
+				AND $1 <%% tags_to_text(tags)
+		) tags
+		WHERE %s
+		ORDER BY score DESC, text
+		LIMIT $2`, similarity("tag"), resembles("tag")), query, limit)
+}
+
+// SuggestAuthors returns the handles of authors of published posts resembling the query, best first
+func SuggestAuthors(ctx context.Context, db *sql.DB, query string, limit int) ([]*Suggestion, error) {
+	return suggest(ctx, db, fmt.Sprintf(`
+		SELECT DISTINCT 'author' AS type, author AS text, NULL::integer AS post_id, %s AS score
+		FROM posts
+		WHERE deleted_at IS NULL AND published_at IS NOT NULL AND %s
+		ORDER BY score DESC, text
+		LIMIT $2`, similarity("author"), resembles("author")), query, limit)
+}
+
+// SuggestTitles returns the titles of published posts resembling the query, best first
+func SuggestTitles(ctx context.Context, db *sql.DB, query string, limit int) ([]*Suggestion, error) {
+	return suggest(ctx, db, fmt.Sprintf(`
+		SELECT 'title' AS type, title AS text, id AS post_id, %s AS score
+		FROM posts
+		WHERE deleted_at IS NULL AND published_at IS NOT NULL AND %s
+		ORDER BY score DESC, text
+		LIMIT $2`, similarity("title"), resembles("title")), query, limit)
+}
+
+// similarity scores a column against the query ($1) as a whole and against
+// the part of the column closest to the query, so that values that are
+// mostly made up of the query rank first
+func similarity(column string) string {
+	return fmt.Sprintf("(similarity(%[1]s, $1) + word_similarity($1, %[1]s)) / 2", column)
+}
+
+// resembles matches the columns that are similar enough to the query ($1).
+// Both operators are served by the trigram indexes
+func resembles(column string) string {
+	return fmt.Sprintf("(%[1]s %% $1 OR $1 <%% %[1]s)", column)
+}
+
+func suggest(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]*Suggestion, error) {
+	var suggestions []*Suggestion
+	if err := queries.Raw(query, args...).Bind(ctx, db, &suggestions); err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
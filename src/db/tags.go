@@ -0,0 +1,319 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// MaxTags is the number of tags a post can have
+const MaxTags = 5
+
+// ErrTooManyTags is returned when a post is given more than MaxTags tags
+var ErrTooManyTags = fmt.Errorf("A post can have at most %d tags.", MaxTags)
+
+// ErrAliasIsTag is returned when a tag is given its own slug as an alias
+var ErrAliasIsTag = errors.New("Alias is the slug of the tag.")
+
+// TagCount is a tag with the number of published posts it is on
+type TagCount struct {
+	models.Tag `boil:",bind"`
+	PostCount  int64 `boil:"post_count"`
+}
+
+// Slugify returns the canonical form of a tag name:
+// trimmed, case folded and with inner whitespace replaced by dashes
+func Slugify(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
+
+// NormalizeTags trims tag names and drops empty ones and those with the same slug
+// as an earlier one. Posts may have at most MaxTags tags
+func NormalizeTags(names []string) ([]string, error) {
+	normalized := []string{}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		slug := Slugify(name)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		normalized = append(normalized, name)
+	}
+
+	if len(normalized) > MaxTags {
+		return nil, ErrTooManyTags
+	}
+	return normalized, nil
+}
+
+// GetTags returns a page of the tags on published posts, most used first
+func GetTags(ctx context.Context, db *sql.DB, limit int, offset int) ([]*TagCount, error) {
+	var tags []*TagCount
+	err := models.NewQuery(
+		qm.Select("tags.*", "count(posts.id) AS post_count"),
+		qm.From("tags"),
+		qm.InnerJoin("post_tags ON post_tags.tag_id = tags.id"),
+		qm.InnerJoin("posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL AND posts.published_at IS NOT NULL"),
+		qm.GroupBy("tags.id"),
+		qm.OrderBy("post_count DESC, tags.slug"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).Bind(ctx, db, &tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// CountTags returns the number of tags on published posts
+func CountTags(ctx context.Context, db *sql.DB) (int64, error) {
+	return models.Tags(qm.Where(`EXISTS (
+		SELECT 1 FROM post_tags
+		INNER JOIN posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL AND posts.published_at IS NOT NULL
+		WHERE post_tags.tag_id = tags.id)`)).Count(ctx, db)
+}
+
+// GetTagBySlug returns a tag by its slug or one of its aliases
+func GetTagBySlug(ctx context.Context, db *sql.DB, slug string) (*models.Tag, error) {
+	tag, err := findTag(ctx, db, Slugify(slug))
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// GetTagAliases returns the aliases of a tag
+func GetTagAliases(ctx context.Context, db *sql.DB, tagID int) (*models.TagAliasSlice, error) {
+	aliases, err := models.TagAliases(qm.Where("tag_id = ?", tagID), qm.OrderBy("alias")).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &aliases, nil
+}
+
+// CountPostsWithTag returns the number of published posts with a tag
+func CountPostsWithTag(ctx context.Context, db *sql.DB, tagID int) (int64, error) {
+	return models.Posts(
+		publishedOnly,
+		qm.InnerJoin("post_tags ON post_tags.post_id = posts.id"),
+		qm.Where("post_tags.tag_id = ?", tagID),
+	).Count(ctx, db)
+}
+
+// CountTagFollowers returns the number of users following a tag
+func CountTagFollowers(ctx context.Context, db *sql.DB, tagID int) (int64, error) {
+	return models.TagFollows(qm.Where("tag_id = ?", tagID)).Count(ctx, db)
+}
+
+// GetPostsWithTag returns a page of the published posts with a tag, newest first
+func GetPostsWithTag(ctx context.Context, db *sql.DB, tagID int, limit int, offset int) (*models.PostSlice, error) {
+	posts, err := models.Posts(append([]qm.QueryMod{
+		publishedOnly,
+		qm.InnerJoin("post_tags ON post_tags.post_id = posts.id"),
+		qm.Where("post_tags.tag_id = ?", tagID),
+		qm.OrderBy("published_at DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	}, withCoAuthors...)...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &posts, nil
+}
+
+// FollowTag makes a user follow a tag. Following a tag twice has no effect
+func FollowTag(ctx context.Context, db *sql.DB, userID int, tagID int) error {
+	follow := &models.TagFollow{UserID: userID, TagID: tagID}
+	return follow.Upsert(ctx, db, false, []string{"user_id", "tag_id"}, boil.None(), boil.Infer())
+}
+
+// UnfollowTag makes a user stop following a tag
+func UnfollowTag(ctx context.Context, db *sql.DB, userID int, tagID int) error {
+	_, err := models.TagFollows(qm.Where("user_id = ? AND tag_id = ?", userID, tagID)).DeleteAll(ctx, db)
+	return err
+}
+
+// IsFollowingTag reports whether a user follows a tag
+func IsFollowingTag(ctx context.Context, db *sql.DB, userID int, tagID int) (bool, error) {
+	return models.TagFollowExists(ctx, db, userID, tagID)
+}
+
+// GetFollowedTags returns the tags a user follows
+func GetFollowedTags(ctx context.Context, db *sql.DB, userID int) (*models.TagSlice, error) {
+	tags, err := models.Tags(
+		qm.InnerJoin("tag_follows ON tag_follows.tag_id = tags.id"),
+		qm.Where("tag_follows.user_id = ?", userID),
+		qm.OrderBy("tags.slug"),
+	).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return &tags, nil
+}
+
+// AddTagAlias makes another slug resolve to a tag. When a tag with that slug exists
+// it is merged into the tag: its posts, followers and aliases move over and it is deleted
+func AddTagAlias(ctx context.Context, db *sql.DB, tag *models.Tag, alias string) (*models.TagAlias, error) {
+	slug := Slugify(alias)
+	if slug == tag.Slug {
+		return nil, ErrAliasIsTag
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	merged, err := models.Tags(qm.Where("slug = ?", slug)).One(ctx, tx)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if merged != nil {
+		if err := mergeTag(ctx, tx, merged, tag); err != nil {
+			return nil, err
+		}
+	}
+
+	tagAlias := &models.TagAlias{Alias: slug, TagID: tag.ID}
+	if err := tagAlias.Upsert(ctx, tx, true, []string{"alias"}, boil.Whitelist("tag_id"), boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tagAlias, nil
+}
+
+// RemoveTagAlias stops an alias from resolving to a tag
+func RemoveTagAlias(ctx context.Context, db *sql.DB, tagID int, alias string) error {
+	tagAlias, err := models.TagAliases(qm.Where("alias = ? AND tag_id = ?", Slugify(alias), tagID)).One(ctx, db)
+	if err != nil {
+		return err
+	}
+	_, err = tagAlias.Delete(ctx, db)
+	return err
+}
+
+// mergeTag moves everything attached to a tag over to another one and deletes it
+func mergeTag(ctx context.Context, exec boil.ContextExecutor, from *models.Tag, into *models.Tag) error {
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		// Posts with either tag now share a tag
+		{`DELETE FROM related_posts WHERE post_id IN (
+			SELECT post_id FROM post_tags WHERE tag_id IN ($1, $2)
+		)`, []interface{}{from.ID, into.ID}},
+		{`INSERT INTO post_tags (post_id, tag_id, position)
+			SELECT post_id, $2, position FROM post_tags WHERE tag_id = $1
+			ON CONFLICT DO NOTHING`, []interface{}{from.ID, into.ID}},
+		{`INSERT INTO tag_follows (user_id, tag_id, created_at)
+			SELECT user_id, $2, created_at FROM tag_follows WHERE tag_id = $1
+			ON CONFLICT DO NOTHING`, []interface{}{from.ID, into.ID}},
+		{`UPDATE tag_aliases SET tag_id = $2 WHERE tag_id = $1`, []interface{}{from.ID, into.ID}},
+		// Replace the slug in place, keeping the first occurrence of each tag
+		{`UPDATE posts SET tags = ARRAY(
+			SELECT tag FROM unnest(array_replace(tags, $1, $2)) WITH ORDINALITY AS t (tag, ord)
+			GROUP BY tag ORDER BY min(ord)
+		) WHERE tags @> ARRAY[$1]::text[]`, []interface{}{from.Slug, into.Slug}},
+	}
+	for _, statement := range statements {
+		if _, err := queries.Raw(statement.query, statement.args...).ExecContext(ctx, exec); err != nil {
+			return err
+		}
+	}
+
+	_, err := from.Delete(ctx, exec)
+	return err
+}
+
+// findTag returns a tag by its slug or one of its aliases
+func findTag(ctx context.Context, exec boil.ContextExecutor, slug string) (*models.Tag, error) {
+	return models.Tags(
+		qm.LeftOuterJoin("tag_aliases ON tag_aliases.tag_id = tags.id"),
+		qm.Where("tags.slug = ? OR tag_aliases.alias = ?", slug, slug),
+	).One(ctx, exec)
+}
+
+// resolveTags returns the tags with the given names, following aliases
+// and creating the ones that do not exist yet
+func resolveTags(ctx context.Context, exec boil.ContextExecutor, names []string) (models.TagSlice, error) {
+	tags := models.TagSlice{}
+	seen := make(map[int]bool)
+	for _, name := range names {
+		slug := Slugify(name)
+		if slug == "" {
+			continue
+		}
+
+		tag, err := findTag(ctx, exec, slug)
+		if err == sql.ErrNoRows {
+			tag, err = createTag(ctx, exec, slug, strings.TrimSpace(name))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// createTag inserts a tag unless another transaction just did
+func createTag(ctx context.Context, exec boil.ContextExecutor, slug string, name string) (*models.Tag, error) {
+	tag := &models.Tag{Slug: slug, Name: name}
+	if err := tag.Upsert(ctx, exec, false, []string{"slug"}, boil.None(), boil.Infer()); err != nil {
+		return nil, err
+	}
+	return models.Tags(qm.Where("slug = ?", slug)).One(ctx, exec)
+}
+
+// tagSlugs returns the slugs of tags in order
+func tagSlugs(tags models.TagSlice) types.StringArray {
+	slugs := make(types.StringArray, len(tags))
+	for i, tag := range tags {
+		slugs[i] = tag.Slug
+	}
+	return slugs
+}
+
+// linkTags replaces the tags of a post and drops the related posts that change with them
+func linkTags(ctx context.Context, exec boil.ContextExecutor, postID int, tags models.TagSlice) error {
+	if _, err := models.PostTags(qm.Where("post_id = ?", postID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	for i, tag := range tags {
+		postTag := &models.PostTag{PostID: postID, TagID: tag.ID, Position: i}
+		if err := postTag.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return invalidateRelatedPosts(ctx, exec, postID)
+}
+
+// tagPost resolves the tag names, stores their slugs on the post and links them to it
+func tagPost(ctx context.Context, exec boil.ContextExecutor, post *models.Post, names []string) error {
+	tags, err := resolveTags(ctx, exec, names)
+	if err != nil {
+		return err
+	}
+	post.Tags = tagSlugs(tags)
+	return linkTags(ctx, exec, post.ID, tags)
+}
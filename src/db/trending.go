@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// TrendingPeriod is a window of time over which posts are ranked.
+// Posts published within Length are ranked, and their scores halve every HalfLife
+type TrendingPeriod struct {
+	Name     string
+	Length   time.Duration
+	HalfLife time.Duration
+}
+
+// TrendingPeriods are the windows posts are ranked over
+var TrendingPeriods = []TrendingPeriod{
+	{"day", 24 * time.Hour, 6 * time.Hour},
+	{"week", 7 * 24 * time.Hour, 36 * time.Hour},
+	{"month", 30 * 24 * time.Hour, 7 * 24 * time.Hour},
+}
+
+// Weights of likes, comments and views in a trending score
+const (
+	likeWeight    = 1.0
+	commentWeight = 2.0
+	viewWeight    = 0.1
+)
+
+// TrendingPost is a post with its trending score and its place in a ranking
+type TrendingPost struct {
+	Post  *models.Post
+	Score float64
+	Rank  int
+}
+
+// GetTrendingPeriod returns the trending period with the given name
+func GetTrendingPeriod(name string) (TrendingPeriod, bool) {
+	for _, period := range TrendingPeriods {
+		if period.Name == name {
+			return period, true
+		}
+	}
+	return TrendingPeriod{}, false
+}
+
+// RecordPostView counts a view of a post on the day of now
+func RecordPostView(ctx context.Context, db *sql.DB, postID int, now time.Time) error {
+	_, err := queries.Raw(`
+		INSERT INTO post_views (post_id, day, views) VALUES ($1, $2, 1)
+		ON CONFLICT (post_id, day) DO UPDATE SET views = post_views.views + 1`,
+		postID, now.UTC().Format("2006-01-02"),
+	).ExecContext(ctx, db)
+	return err
+}
+
+// RecomputeTrending replaces the rankings of every period with the scores of posts at now.
+// A score adds up weighted likes and views within the period, each decayed by when it happened,
+// and comments (one per line), which carry no time and are decayed by the age of the post
+func RecomputeTrending(ctx context.Context, db *sql.DB, now time.Time) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, period := range TrendingPeriods {
+		if _, err := models.PostRankings(qm.Where("period = ?", period.Name)).DeleteAll(ctx, tx); err != nil {
+			return err
+		}
+
+		since := now.Add(-period.Length)
+		_, err := queries.Raw(`
+			INSERT INTO post_rankings (period, post_id, score, rank, computed_at)
+			SELECT $1::varchar, id, score, row_number() OVER (ORDER BY score DESC, id DESC), $2::timestamptz
+			FROM (
+				SELECT posts.id,
+					coalesce(likes.likes, 0) * $5::float8
+						+ cardinality(array_remove(string_to_array(coalesce(posts.comments, ''), E'\n'), '')) * $6::float8
+							* exp(-ln(2) * extract(epoch FROM $2::timestamptz - posts.published_at) / $4::float8)
+						+ coalesce(views.views, 0) * $7::float8 AS score
+				FROM posts
+				LEFT JOIN (
+					SELECT post_id,
+						sum(views * exp(-ln(2) * extract(epoch FROM $2::timestamptz - (day::timestamp AT TIME ZONE 'UTC')) / $4::float8)) AS views
+					FROM post_views
+					WHERE day BETWEEN ($3::timestamptz AT TIME ZONE 'UTC')::date AND ($2::timestamptz AT TIME ZONE 'UTC')::date
+					GROUP BY post_id
+				) views ON views.post_id = posts.id
+				LEFT JOIN (
+					SELECT post_id,
+						sum(exp(-ln(2) * extract(epoch FROM $2::timestamptz - created_at) / $4::float8)) AS likes
+					FROM post_likes
+					WHERE created_at > $3::timestamptz AND created_at <= $2::timestamptz
+					GROUP BY post_id
+				) likes ON likes.post_id = posts.id
+				WHERE posts.deleted_at IS NULL
+					AND posts.published_at > $3::timestamptz AND posts.published_at <= $2::timestamptz
+			) scored
+			WHERE score > 0`,
+			period.Name, now, since, period.HalfLife.Seconds(), likeWeight, commentWeight, viewWeight,
+		).ExecContext(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetTrendingPosts returns a page of the ranking of a period, optionally limited to posts with a tag.
+// Ranks are places within the returned ranking
+func GetTrendingPosts(ctx context.Context, db *sql.DB, period string, tag string, limit int, offset int) ([]*TrendingPost, error) {
+	mods := []qm.QueryMod{
+		qm.InnerJoin("posts ON posts.id = post_rankings.post_id AND posts.deleted_at IS NULL"),
+		qm.Where("post_rankings.period = ?", period),
+		qm.OrderBy("post_rankings.rank"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+		qm.Load(models.PostRankingRels.Post),
+	}
+	mods = append(mods, withCoAuthorsOf(models.PostRankingRels.Post)...)
+	if tag != "" {
+		mods = append(mods, qm.Where("posts.tags @> ?", pq.Array([]string{tag})))
+	}
+
+	rankings, err := models.PostRankings(mods...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	trending := make([]*TrendingPost, len(rankings))
+	for i, ranking := range rankings {
+		trending[i] = &TrendingPost{ranking.R.Post, ranking.Score, offset + i + 1}
+	}
+	return trending, nil
+}
@@ -0,0 +1,298 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// ErrQuotaExceeded is returned when an upload would take a user over their storage quota
+var ErrQuotaExceeded = errors.New("Upload quota exceeded.")
+
+// CreateUpload records an image uploaded by a user along with its variants,
+// counting its size against their quota. Uploading the same image again
+// returns the existing upload without counting it twice. store writes the blobs
+// of the image; it is called with the hash locked so that the sweeper cannot
+// delete them before the upload is recorded
+func CreateUpload(ctx context.Context, db *sql.DB, upload *models.Upload, variants models.UploadVariantSlice, quota int64, store func() error) (*models.Upload, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Concurrent uploads of one user are counted one after another
+	if _, err := models.Users(qm.Where("id = ?", upload.UserID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+		return nil, err
+	}
+	if err := lockUploadHashes(ctx, tx, []string{upload.Hash}); err != nil {
+		return nil, err
+	}
+
+	existing, err := models.Uploads(append([]qm.QueryMod{qm.Where("user_id = ? AND hash = ?", upload.UserID, upload.Hash)}, withVariants...)...).One(ctx, tx)
+	if err == nil {
+		return existing, tx.Commit()
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	used, err := uploadUsage(ctx, tx, upload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if used+upload.Size > quota {
+		return nil, ErrQuotaExceeded
+	}
+
+	if err := store(); err != nil {
+		return nil, err
+	}
+	if err := upload.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if err := upload.AddUploadVariants(ctx, tx, true, variants...); err != nil {
+		return nil, err
+	}
+	return upload, tx.Commit()
+}
+
+// GetUploadUsage returns the number of bytes a user has uploaded
+func GetUploadUsage(ctx context.Context, db *sql.DB, userID int) (int64, error) {
+	return uploadUsage(ctx, db, userID)
+}
+
+// GetUserUploads returns the uploads of a user, newest first
+func GetUserUploads(ctx context.Context, db *sql.DB, userID int) (models.UploadSlice, error) {
+	return models.Uploads(append([]qm.QueryMod{qm.Where("user_id = ?", userID), qm.OrderBy("created_at DESC, id DESC")}, withVariants...)...).All(ctx, db)
+}
+
+// GetUploadByHash returns an upload of the blob with a hash
+func GetUploadByHash(ctx context.Context, db *sql.DB, hash string) (*models.Upload, error) {
+	return models.Uploads(append([]qm.QueryMod{qm.Where("hash = ?", hash), qm.OrderBy("id")}, withVariants...)...).One(ctx, db)
+}
+
+// withVariants loads the variants of uploads, narrowest first
+var withVariants = []qm.QueryMod{qm.Load(models.UploadRels.UploadVariants, qm.OrderBy("width"))}
+
+func uploadUsage(ctx context.Context, exec boil.ContextExecutor, userID int) (int64, error) {
+	var usage struct {
+		Used int64 `boil:"used"`
+	}
+	err := queries.Raw("SELECT coalesce(sum(size), 0)::bigint AS used FROM uploads WHERE user_id = $1", userID).Bind(ctx, exec, &usage)
+	return usage.Used, err
+}
+
+// uploadReference matches the hash in the URL of an uploaded image or one of its variants
+var uploadReference = regexp.MustCompile(`/uploads/([0-9a-f]{64})`)
+
+// uploadHashes returns the hashes of the uploaded images referred to in a text, without duplicates
+func uploadHashes(text string) types.StringArray {
+	hashes := types.StringArray{}
+	seen := map[string]bool{}
+	for _, m := range uploadReference.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			hashes = append(hashes, m[1])
+		}
+	}
+	return hashes
+}
+
+// lockUploadHashes takes the transaction level advisory locks of the hashes, in order so that
+// transactions locking several hashes cannot deadlock. Recording uploads or references to them
+// and sweeping their blobs hold these locks, so a blob is never deleted while it is being referred to
+func lockUploadHashes(ctx context.Context, exec boil.ContextExecutor, hashes []string) error {
+	sorted := append([]string{}, hashes...)
+	sort.Strings(sorted)
+	for _, hash := range sorted {
+		if _, err := queries.Raw("SELECT pg_advisory_xact_lock(hashtext($1))", hash).ExecContext(ctx, exec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// referenceUploads records the uploaded images embedded in the document of a post.
+// Images removed from the document keep their references, since the revisions of the post
+// still embed them and can be restored. The references go when the post is purged
+func referenceUploads(ctx context.Context, exec boil.ContextExecutor, post *models.Post) error {
+	hashes := uploadHashes(post.Document.String)
+	if err := lockUploadHashes(ctx, exec, hashes); err != nil {
+		return err
+	}
+
+	_, err := queries.Raw(
+		"INSERT INTO upload_refs (hash, post_id) SELECT unnest($2::text[]), $1 ON CONFLICT DO NOTHING", post.ID, hashes,
+	).ExecContext(ctx, exec)
+	return err
+}
+
+// referenceUserUploads records the uploaded image used as the avatar of a user,
+// forgetting the one it replaced
+func referenceUserUploads(ctx context.Context, exec boil.ContextExecutor, user *models.User) error {
+	hashes := uploadHashes(user.Avatar.String)
+	if err := lockUploadHashes(ctx, exec, hashes); err != nil {
+		return err
+	}
+
+	if _, err := queries.Raw(
+		"DELETE FROM user_upload_refs WHERE user_id = $1 AND NOT (hash = ANY($2))", user.ID, hashes,
+	).ExecContext(ctx, exec); err != nil {
+		return err
+	}
+	_, err := queries.Raw(
+		"INSERT INTO user_upload_refs (hash, user_id) SELECT unnest($2::text[]), $1 ON CONFLICT DO NOTHING", user.ID, hashes,
+	).ExecContext(ctx, exec)
+	return err
+}
+
+// OrphanedBlob is a stored image, with the widths of its variants, that no upload refers to
+type OrphanedBlob struct {
+	Hash   string
+	Widths []int
+	// Size is the number of bytes of the image and its variants
+	Size int64
+}
+
+// UploadSweep lists the uploads that no post or user refers to and the blobs left without an upload
+type UploadSweep struct {
+	Uploads models.UploadSlice
+	Blobs   []*OrphanedBlob
+}
+
+// FindOrphanedUploads returns the uploads created before a time whose image is not embedded
+// in any post or revision of a post or used by any user, without removing them
+func FindOrphanedUploads(ctx context.Context, db *sql.DB, before time.Time) (*UploadSweep, error) {
+	return findOrphanedUploads(ctx, db, before)
+}
+
+// SweepOrphanedUploads deletes the uploads created before a time whose image is not
+// embedded in any post or revision of a post or used by any user. The blobs in the returned
+// sweep are left to the caller to delete from the blob store with DeleteOrphanedBlob.
+// The rows are locked with FOR UPDATE SKIP LOCKED so that several server instances
+// running the sweeper never sweep the same upload twice.
+func SweepOrphanedUploads(ctx context.Context, db *sql.DB, before time.Time) (*UploadSweep, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The candidates are looked up again once their hashes are locked,
+	// in case a post or a user started referring to them meanwhile
+	candidates, err := findOrphanedUploads(ctx, tx, before)
+	if err != nil {
+		return nil, err
+	}
+	hashes := []string{}
+	for _, u := range candidates.Uploads {
+		hashes = append(hashes, u.Hash)
+	}
+	if err := lockUploadHashes(ctx, tx, hashes); err != nil {
+		return nil, err
+	}
+
+	sweep, err := findOrphanedUploads(ctx, tx, before, qm.For("UPDATE SKIP LOCKED"))
+	if err != nil {
+		return nil, err
+	}
+	if len(sweep.Uploads) > 0 {
+		if _, err := sweep.Uploads.DeleteAll(ctx, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return sweep, nil
+}
+
+// DeleteOrphanedBlob calls remove to delete a blob from the blob store, holding the lock of
+// its hash, unless an upload of the blob was recorded or something referred to it since the sweep.
+// It reports whether the blob was deleted
+func DeleteOrphanedBlob(ctx context.Context, db *sql.DB, hash string, remove func() error) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if err := lockUploadHashes(ctx, tx, []string{hash}); err != nil {
+		return false, err
+	}
+
+	var refs struct {
+		Exists bool `boil:"exists"`
+	}
+	if err := queries.Raw(`SELECT EXISTS (SELECT 1 FROM uploads WHERE hash = $1)
+		OR EXISTS (SELECT 1 FROM upload_refs WHERE hash = $1)
+		OR EXISTS (SELECT 1 FROM user_upload_refs WHERE hash = $1) AS exists`, hash).Bind(ctx, tx, &refs); err != nil {
+		return false, err
+	}
+	if refs.Exists {
+		return false, tx.Commit()
+	}
+
+	if err := remove(); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+func findOrphanedUploads(ctx context.Context, exec boil.ContextExecutor, before time.Time, mods ...qm.QueryMod) (*UploadSweep, error) {
+	uploads, err := models.Uploads(append(append([]qm.QueryMod{
+		qm.Where(`created_at < ? AND NOT EXISTS (SELECT 1 FROM upload_refs WHERE upload_refs.hash = uploads.hash)
+			AND NOT EXISTS (SELECT 1 FROM user_upload_refs WHERE user_upload_refs.hash = uploads.hash)`, before),
+		qm.OrderBy("id"),
+	}, withVariants...), mods...)...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep := &UploadSweep{Uploads: uploads}
+	ids := types.Int64Array{}
+	for _, u := range uploads {
+		ids = append(ids, int64(u.ID))
+	}
+	for _, u := range uploads {
+		if blobListed(sweep.Blobs, u.Hash) {
+			continue
+		}
+		// Another user may have uploaded the same image more recently
+		others, err := models.Uploads(qm.Where("hash = ? AND NOT (id = ANY(?))", u.Hash, ids)).Count(ctx, exec)
+		if err != nil {
+			return nil, err
+		}
+		if others > 0 {
+			continue
+		}
+
+		blob := &OrphanedBlob{Hash: u.Hash, Size: u.Size}
+		for _, v := range u.R.UploadVariants {
+			blob.Widths = append(blob.Widths, v.Width)
+			blob.Size += v.Size
+		}
+		sweep.Blobs = append(sweep.Blobs, blob)
+	}
+	return sweep, nil
+}
+
+func blobListed(blobs []*OrphanedBlob, hash string) bool {
+	for _, b := range blobs {
+		if b.Hash == hash {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// BlockUser makes a user block another user, who can no longer mention them.
+// The blocked user stops following the user
+func BlockUser(ctx context.Context, db *sql.DB, blockerID int, blockedID int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = queries.Raw(`
+		INSERT INTO user_blocks (blocker_id, blocked_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, blockerID, blockedID).ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	_, err = models.UserFollows(qm.Where("follower_id = ? AND followee_id = ?", blockedID, blockerID)).DeleteAll(ctx, tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// UnblockUser makes a user stop blocking another user
+func UnblockUser(ctx context.Context, db *sql.DB, blockerID int, blockedID int) error {
+	_, err := models.UserBlocks(qm.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID)).DeleteAll(ctx, db)
+	return err
+}
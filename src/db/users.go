@@ -15,6 +15,8 @@ type User struct {
 	Email          string
 	Password       string
 	TokenExpiresIn int64
+	// Avatar is the URL of the image of the user, usually an upload. Nil keeps it, empty removes it
+	Avatar *string
 }
 
 // GetUserByID retrieves a user by its ID
@@ -65,10 +67,21 @@ func UpdateUser(ctx context.Context, db *sql.DB, id int64, u *User) (*models.Use
 	}
 	updateUserModel(user, u)
 
-	if _, err := user.Update(ctx, db, boil.Infer()); err != nil {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
 		return nil, err
 	}
-	return user, nil
+	defer tx.Rollback()
+
+	if _, err := user.Update(ctx, tx, boil.Infer()); err != nil {
+		return nil, err
+	}
+	if u.Avatar != nil {
+		if err := referenceUserUploads(ctx, tx, user); err != nil {
+			return nil, err
+		}
+	}
+	return user, tx.Commit()
 }
 
 // UpdateTokenExpiresIn updates the TokenExpiresIn column of the given user
@@ -90,6 +103,9 @@ func updateUserModel(user *models.User, u *User) {
 	if u.TokenExpiresIn > -1 {
 		user.TokenExpiresIn = null.Int64From(u.TokenExpiresIn)
 	}
+	if u.Avatar != nil {
+		user.Avatar = null.NewString(*u.Avatar, *u.Avatar != "")
+	}
 }
 
 func BindDataToUserModel(u *User) *models.User {
@@ -0,0 +1,124 @@
+package diff
+
+import "strings"
+
+// Operations applied to a line when turning the old text into the new one
+const (
+	Equal  = "equal"
+	Insert = "insert"
+	Delete = "delete"
+)
+
+// MaxComparisons is the largest number of line pairs compared to find the longest common
+// subsequence. Larger changes are shown as the old lines deleted and the new ones inserted,
+// so that diffing large documents cannot exhaust memory
+const MaxComparisons = 1 << 20
+
+// Line is a single line of a line-based diff
+type Line struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// Lines returns the line-based diff that turns a into b,
+// computed from the longest common subsequence of their lines.
+// Changes spanning more than MaxComparisons line pairs are not minimized
+func Lines(a, b string) []Line {
+	oldLines := splitLines(a)
+	newLines := splitLines(b)
+
+	// Common prefix and suffix need no comparison table
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	var lines []Line
+	for _, l := range oldLines[:prefix] {
+		lines = append(lines, Line{Equal, l})
+	}
+	lines = append(lines, diffMiddle(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	for _, l := range oldLines[len(oldLines)-suffix:] {
+		lines = append(lines, Line{Equal, l})
+	}
+	return lines
+}
+
+// Count returns the number of inserted and deleted lines in a diff
+func Count(lines []Line) (insertions, deletions int) {
+	for _, l := range lines {
+		switch l.Op {
+		case Insert:
+			insertions++
+		case Delete:
+			deletions++
+		}
+	}
+	return insertions, deletions
+}
+
+func diffMiddle(a, b []string) []Line {
+	if len(a)*len(b) > MaxComparisons {
+		lines := make([]Line, 0, len(a)+len(b))
+		for _, l := range a {
+			lines = append(lines, Line{Delete, l})
+		}
+		for _, l := range b {
+			lines = append(lines, Line{Insert, l})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []Line
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Equal, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Delete, a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Insert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Delete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Insert, b[j]})
+	}
+	return lines
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,119 @@
+package images
+
+import (
+	"image"
+	"math"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	// blurHashX and blurHashY are the number of components of a blur hash along each axis
+	blurHashX = 4
+	blurHashY = 3
+	// blurHashSize is the width of the thumbnail the blur hash is computed from
+	blurHashSize = 32
+
+	base83 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+)
+
+// BlurHash encodes a tiny blurred representation of an image, see https://blurha.sh
+func BlurHash(img image.Image) string {
+	bounds := img.Bounds()
+	w := blurHashSize
+	h := (bounds.Dy()*blurHashSize + bounds.Dx()/2) / bounds.Dx()
+	if h < 1 {
+		h = 1
+	} else if h > blurHashSize*4 {
+		h = blurHashSize * 4
+	}
+	thumb := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.ApproxBiLinear.Scale(thumb, thumb.Bounds(), img, bounds, draw.Src, nil)
+
+	var factors [blurHashX * blurHashY][3]float64
+	for j := 0; j < blurHashY; j++ {
+		for i := 0; i < blurHashX; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+			var r, g, b float64
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					basis := math.Cos(math.Pi*float64(i*x)/float64(w)) * math.Cos(math.Pi*float64(j*y)/float64(h))
+					p := thumb.Pix[y*thumb.Stride+x*4:]
+					r += basis * srgbToLinear(p[0])
+					g += basis * srgbToLinear(p[1])
+					b += basis * srgbToLinear(p[2])
+				}
+			}
+			scale := normalisation / float64(w*h)
+			factors[j*blurHashX+i] = [3]float64{r * scale, g * scale, b * scale}
+		}
+	}
+
+	var hash strings.Builder
+	writeBase83(&hash, (blurHashX-1)+(blurHashY-1)*9, 1)
+
+	maximum := 0.0
+	for _, f := range factors[1:] {
+		for _, c := range f {
+			maximum = math.Max(maximum, math.Abs(c))
+		}
+	}
+	quantisedMaximum := 0
+	if maximum > 0 {
+		quantisedMaximum = clamp(int(math.Floor(maximum*166-0.5)), 0, 82)
+	}
+	acMaximum := float64(quantisedMaximum+1) / 166
+	writeBase83(&hash, quantisedMaximum, 1)
+
+	dc := factors[0]
+	writeBase83(&hash, linearToSrgb(dc[0])<<16+linearToSrgb(dc[1])<<8+linearToSrgb(dc[2]), 4)
+	for _, f := range factors[1:] {
+		value := 0
+		for _, c := range f {
+			value = value*19 + clamp(int(math.Floor(signPow(c/acMaximum, 0.5)*9+9.5)), 0, 18)
+		}
+		writeBase83(&hash, value, 2)
+	}
+	return hash.String()
+}
+
+func writeBase83(b *strings.Builder, value, length int) {
+	for i := 1; i <= length; i++ {
+		digit := value / int(math.Pow(83, float64(length-i))) % 83
+		b.WriteByte(base83[digit])
+	}
+}
+
+func srgbToLinear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSrgb(v float64) int {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
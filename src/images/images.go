@@ -0,0 +1,129 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	// Registers the GIF decoder with image.Decode
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"sort"
+
+	"golang.org/x/image/draw"
+	// Registers the WebP decoder with image.Decode
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// MaxPixels is the largest image that is decoded, so that a small file
+	// cannot claim gigabytes of memory
+	MaxPixels = 50000000
+
+	jpegQuality = 85
+)
+
+var (
+	// ErrInvalidImage is returned for data that does not decode as a supported image
+	ErrInvalidImage = errors.New("Invalid image.")
+	// ErrTooManyPixels is returned for images over MaxPixels
+	ErrTooManyPixels = errors.New("Image dimensions too large.")
+)
+
+// Image is an encoded image
+type Image struct {
+	Width       int
+	Height      int
+	ContentType string
+	Data        []byte
+}
+
+// Processed is an uploaded image prepared for serving
+type Processed struct {
+	// Original is the full size image without its metadata
+	Original Image
+	// Variants are the downscaled copies, narrowest first
+	Variants []Image
+	// BlurHash is a placeholder to show while the image loads
+	BlurHash string
+}
+
+// Process decodes a JPEG, PNG, GIF or WebP image, applies its EXIF orientation
+// and re-encodes it without metadata, along with a variant for each of the widths
+// narrower than the image. GIFs keep their original bytes so that animations survive.
+// Images with transparency are encoded as PNG and all others as JPEG,
+// except that PNGs stay PNGs.
+func Process(data []byte, widths []int) (*Processed, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	img = orient(img, exifOrientation(data))
+
+	bounds := img.Bounds()
+	processed := &Processed{BlurHash: BlurHash(img)}
+	if format == "gif" {
+		processed.Original = Image{bounds.Dx(), bounds.Dy(), "image/gif", data}
+	} else if processed.Original, err = encode(img, format == "png"); err != nil {
+		return nil, err
+	}
+
+	sorted := append([]int(nil), widths...)
+	sort.Ints(sorted)
+	for i, width := range sorted {
+		if width <= 0 || width >= bounds.Dx() || (i > 0 && width == sorted[i-1]) {
+			continue
+		}
+		variant, err := encode(resize(img, width), format == "png")
+		if err != nil {
+			return nil, err
+		}
+		processed.Variants = append(processed.Variants, variant)
+	}
+	return processed, nil
+}
+
+// resize scales an image down to a width, keeping its aspect ratio
+func resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	height := (bounds.Dy()*width + bounds.Dx()/2) / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// encode encodes an image as PNG when it has transparency or asPNG is set, and as JPEG otherwise
+func encode(img image.Image, asPNG bool) (Image, error) {
+	bounds := img.Bounds()
+	var buf bytes.Buffer
+	var err error
+	contentType := "image/jpeg"
+	if asPNG || !opaque(img) {
+		contentType = "image/png"
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return Image{}, err
+	}
+	return Image{bounds.Dx(), bounds.Dy(), contentType, buf.Bytes()}, nil
+}
+
+func opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Clock returns the current time.
+// Jobs read the time through a Clock so tests can run them at fixed timestamps
+type Clock func() time.Time
+
+// Job is a unit of background work that is executed periodically
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+// Runner executes its jobs in the background of the server process
+type Runner struct {
+	logger *logrus.Logger
+	clock  Clock
+	jobs   []*Job
+}
+
+// NewRunner returns a Runner reading the time from the given clock
+func NewRunner(l *logrus.Logger, clock Clock) *Runner {
+	return &Runner{logger: l, clock: clock}
+}
+
+// Add registers a job with the runner
+func (r *Runner) Add(j *Job) {
+	r.jobs = append(r.jobs, j)
+}
+
+// Start runs every registered job once, then on its own interval until ctx is cancelled
+func (r *Runner) Start(ctx context.Context) {
+	for _, j := range r.jobs {
+		go r.loop(ctx, j)
+	}
+}
+
+func (r *Runner) loop(ctx context.Context, j *Job) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	r.run(ctx, j)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.run(ctx, j)
+		}
+	}
+}
+
+func (r *Runner) run(ctx context.Context, j *Job) {
+	if err := j.Run(ctx, r.clock()); err != nil {
+		r.logger.WithField("job", j.Name).Error(err)
+	}
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+const publishBatchSize = 100
+
+// PublishScheduledPosts returns a job that publishes posts whose publish time has passed
+func PublishScheduledPosts(pool *sql.DB) *Job {
+	return &Job{
+		Name:     "publish-scheduled-posts",
+		Interval: time.Minute,
+		Run: func(ctx context.Context, now time.Time) error {
+			for {
+				posts, err := db.PublishDuePosts(ctx, pool, now, publishBatchSize)
+				if err != nil {
+					return err
+				}
+				if len(*posts) < publishBatchSize {
+					return nil
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// PurgeDeletedPosts returns a job that permanently removes posts
+// which have been in the trash for longer than the retention period
+func PurgeDeletedPosts(pool *sql.DB, retention time.Duration) *Job {
+	return &Job{
+		Name:     "purge-deleted-posts",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			_, err := db.PurgeDeletedPosts(ctx, pool, now.Add(-retention))
+			return err
+		},
+	}
+}
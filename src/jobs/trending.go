@@ -0,0 +1,20 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+// RecomputeTrending returns a job that refreshes the trending rankings of every period
+func RecomputeTrending(pool *sql.DB) *Job {
+	return &Job{
+		Name:     "recompute-trending",
+		Interval: 10 * time.Minute,
+		Run: func(ctx context.Context, now time.Time) error {
+			return db.RecomputeTrending(ctx, pool, now)
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
+)
+
+// SweepOrphanedUploads returns a job that deletes uploads which no post has embedded and no user
+// has used within the grace period, along with the blobs no other upload refers to
+func SweepOrphanedUploads(pool *sql.DB, store storage.BlobStore, grace time.Duration) *Job {
+	return &Job{
+		Name:     "sweep-orphaned-uploads",
+		Interval: time.Hour,
+		Run: func(ctx context.Context, now time.Time) error {
+			sweep, err := db.SweepOrphanedUploads(ctx, pool, now.Add(-grace))
+			if err != nil {
+				return err
+			}
+
+			// The uploads are gone, so a blob that fails to delete is only wasted space
+			// and the others are still worth deleting
+			var firstErr error
+			for _, blob := range sweep.Blobs {
+				keys := []string{storage.ImageKey(blob.Hash, 0)}
+				for _, width := range blob.Widths {
+					keys = append(keys, storage.ImageKey(blob.Hash, width))
+				}
+				_, err := db.DeleteOrphanedBlob(ctx, pool, blob.Hash, func() error {
+					var deleteErr error
+					for _, key := range keys {
+						if err := store.Delete(ctx, key); err != nil && deleteErr == nil {
+							deleteErr = err
+						}
+					}
+					return deleteErr
+				})
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+			}
+			return firstErr
+		},
+	}
+}
@@ -10,10 +10,12 @@
 
 package main
 
-//go:generate sqlboiler --wipe psql
+//go:generate sqlboiler --wipe --add-soft-deletes psql
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	_ "github.com/json9512/mediumclone-backendwithgo/src/docs"
@@ -23,8 +25,12 @@ import (
 
 	"github.com/json9512/mediumclone-backendwithgo/src/config"
 	DBProvider "github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
 	"github.com/json9512/mediumclone-backendwithgo/src/middlewares"
+	"github.com/json9512/mediumclone-backendwithgo/src/payments"
 	"github.com/json9512/mediumclone-backendwithgo/src/routes"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
 )
 
 // SetupRouter returns the API server
@@ -41,12 +47,39 @@ func SetupRouter(mode string, logger *logrus.Logger, db *sql.DB) *gin.Engine {
 		router.Use(middlewares.CustomLogger(logger))
 	}
 
+	provider, err := payments.New(envVars.PaymentProvider)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	store, err := NewBlobStore(envVars)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	broker := stream.NewBroker(envVars.StreamReplaySize, envVars.StreamQueueSize)
+	stream.Listen(context.Background(), DBProvider.DataSource(), broker, logger)
+
 	router.Use(gin.Recovery())
-	routes.AddRoutes(router, db, envVars)
+	routes.AddRoutes(router, db, envVars, provider, store, broker)
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	return router
 }
 
+// NewBlobStore returns the blob store configured by the environment
+func NewBlobStore(envVars *config.EnvVars) (storage.BlobStore, error) {
+	return storage.New(storage.Config{
+		Backend: envVars.StorageBackend,
+		Dir:     envVars.StorageDir,
+		S3: storage.S3Config{
+			Endpoint:  envVars.S3Endpoint,
+			Region:    envVars.S3Region,
+			Bucket:    envVars.S3Bucket,
+			AccessKey: envVars.S3AccessKey,
+			SecretKey: envVars.S3SecretKey,
+		},
+	})
+}
+
 func main() {
 	logger := config.InitLogger()
 	config.ReadVariablesFromFile(".env")
@@ -57,6 +90,21 @@ func main() {
 		logger.Error(err)
 	}
 
+	envVars := config.LoadEnvVars()
+	trashRetention := time.Duration(envVars.TrashRetentionDays) * 24 * time.Hour
+	uploadGrace := time.Duration(envVars.UploadGraceHours) * time.Hour
+	store, err := NewBlobStore(envVars)
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	runner := jobs.NewRunner(logger, time.Now)
+	runner.Add(jobs.PublishScheduledPosts(dbContainer.DB))
+	runner.Add(jobs.PurgeDeletedPosts(dbContainer.DB, trashRetention))
+	runner.Add(jobs.RecomputeTrending(dbContainer.DB))
+	runner.Add(jobs.SweepOrphanedUploads(dbContainer.DB, store, uploadGrace))
+	runner.Start(context.Background())
+
 	r := SetupRouter("debug", logger, dbContainer.DB)
 	r.Run() // Port 8080
 }
@@ -14,12 +14,17 @@ import (
 
 func createTestContainer(t *testing.T) *tests.Container {
 	config.ReadVariablesFromFile(".env")
+	os.Setenv("STORAGE_DIR", t.TempDir())
 	envVars := config.LoadEnvVars()
 	logger := config.InitLogger()
 	container := db.Init(logger)
 	container.Migrate("up")
 
 	router := SetupRouter("test", logger, container.DB)
+	store, err := NewBlobStore(envVars)
+	if err != nil {
+		t.Fatal(err)
+	}
 	g := goblin.Goblin(t)
 
 	testContainer := tests.Container{
@@ -28,15 +33,40 @@ func createTestContainer(t *testing.T) *tests.Container {
 		DB:      container.DB,
 		Context: context.Background(),
 		Env:     envVars,
+		Store:   store,
 	}
 	return &testContainer
 }
 
 func Test(t *testing.T) {
 	testContainer := createTestContainer(t)
-	defer testContainer.DB.Exec("DROP TABLE gorp_migrations;DROP TABLE users;DROP TABLE posts;")
+	defer testContainer.DB.Exec("DROP TABLE gorp_migrations;DROP SEQUENCE stream_event_ids;DROP TABLE comment_mentions;DROP TABLE post_mentions;DROP TABLE user_blocks;DROP TABLE notification_preferences;DROP TABLE notification_actors;DROP TABLE notifications;DROP TABLE user_follows;DROP TABLE tag_follows;DROP TABLE post_tags;DROP TABLE tag_aliases;DROP TABLE tags;DROP TABLE series_posts;DROP TABLE series;DROP TABLE share_link_comments;DROP TABLE share_link_accesses;DROP TABLE share_links;DROP TABLE metered_reads;DROP TABLE subscriptions;DROP TABLE post_authors;DROP TABLE submissions;DROP TABLE publication_members;DROP TABLE highlights;DROP TABLE reading_list_posts;DROP TABLE reading_lists;DROP TABLE related_posts;DROP TABLE post_likes;DROP TABLE user_upload_refs;DROP TABLE upload_refs;DROP TABLE upload_variants;DROP TABLE uploads;DROP TABLE users;DROP TABLE post_revisions;DROP TABLE post_rankings;DROP TABLE post_views;DROP TABLE posts;DROP TABLE publications;")
 
 	tests.RunPostsTests(testContainer)
+	tests.RunScheduleTests(testContainer)
+	tests.RunJobsTests(testContainer)
+	tests.RunRevisionsTests(testContainer)
+	tests.RunTrashTests(testContainer)
+	tests.RunMarkdownTests(testContainer)
+	tests.RunBlocksTests(testContainer)
+	tests.RunSummaryTests(testContainer)
+	tests.RunSearchTests(testContainer)
+	tests.RunTagsTests(testContainer)
+	tests.RunFiltersTests(testContainer)
+	tests.RunTrendingTests(testContainer)
+	tests.RunRelatedTests(testContainer)
+	tests.RunListsTests(testContainer)
+	tests.RunHighlightsTests(testContainer)
+	tests.RunSeriesTests(testContainer)
+	tests.RunPublicationsTests(testContainer)
+	tests.RunCoAuthorsTests(testContainer)
+	tests.RunSubscriptionsTests(testContainer)
+	tests.RunSharesTests(testContainer)
+	tests.RunUploadsTests(testContainer)
+	tests.RunNotificationsTests(testContainer)
+	tests.RunMentionsTests(testContainer)
+	tests.RunUserBlocksTests(testContainer)
+	tests.RunStreamTests(testContainer)
 	tests.RunUsersTests(testContainer)
 	tests.RunAuthTests(testContainer)
 
@@ -0,0 +1,185 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+// Heading is an entry in the table of contents of a document
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
+
+// Rendered holds the sanitized HTML of a document and its table of contents
+type Rendered struct {
+	HTML string
+	TOC  []Heading
+}
+
+var converter = goldmark.New(
+	goldmark.WithExtensions(extension.GFM, extension.Footnote),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID(), parser.WithInlineParsers(mentions)),
+	// Raw HTML is kept here and left to the sanitizer
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+var policy = newPolicy()
+
+// newPolicy allows user generated content plus the markup
+// produced by the CommonMark and GFM extensions in use
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowElements("section")
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_:-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6", "sup", "li")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(footnote(s|-ref|-backref)|mention)$`)).OnElements("a", "section")
+	p.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-(noteref|backlink|endnotes|endnote)$`)).
+		OnElements("a", "section", "li")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// Render converts a CommonMark document into sanitized HTML
+// and collects its headings as a table of contents
+func Render(source string) *Rendered {
+	return RenderWithMentions(source, nil)
+}
+
+// RenderWithMentions renders a document like Render, linking the mentions
+// of the handles in profiles to the URL of their profile
+func RenderWithMentions(source string, profiles map[string]string) *Rendered {
+	src := []byte(source)
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{used: map[string]int{}}))
+	ctx.Set(mentionsKey, &mentionState{profiles: profiles, seen: map[string]bool{}})
+	doc := converter.Parser().Parse(text.NewReader(src), parser.WithContext(ctx))
+
+	var buf bytes.Buffer
+	// Writing into a bytes.Buffer does not fail
+	_ = converter.Renderer().Render(&buf, src, doc)
+
+	return &Rendered{
+		HTML: policy.Sanitize(buf.String()),
+		TOC:  tableOfContents(doc, src),
+	}
+}
+
+// headingIDs generates heading anchors that keep letters of every script,
+// so Korean headings get readable anchors as well
+type headingIDs struct {
+	used map[string]int
+}
+
+func (h *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	var b strings.Builder
+	separate := false
+	for _, r := range strings.ToLower(string(value)) {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			separate = true
+			continue
+		}
+		if separate && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		separate = false
+		b.WriteRune(r)
+	}
+
+	id := b.String()
+	if id == "" {
+		id = "heading"
+	}
+	if count := h.used[id]; count > 0 {
+		h.used[id]++
+		id = fmt.Sprintf("%s-%d", id, count)
+	}
+	h.Put([]byte(id))
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.used[string(value)]++
+}
+
+func tableOfContents(doc ast.Node, src []byte) []Heading {
+	toc := []Heading{}
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var id string
+		if value, exists := heading.AttributeString("id"); exists {
+			if b, ok := value.([]byte); ok {
+				id = string(b)
+			}
+		}
+
+		toc = append(toc, Heading{
+			Level: heading.Level,
+			Text:  string(heading.Text(src)),
+			ID:    id,
+		})
+		return ast.WalkSkipChildren, nil
+	})
+	return toc
+}
+
+// PlainText returns the text of a markdown document without any markup,
+// one line per block
+func PlainText(source string) string {
+	src := []byte(source)
+	doc := converter.Parser().Parse(text.NewReader(src))
+
+	var blocks []string
+	var current strings.Builder
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch node := n.(type) {
+		case *ast.Text:
+			if entering {
+				current.Write(node.Segment.Value(src))
+				if node.SoftLineBreak() || node.HardLineBreak() {
+					current.WriteByte(' ')
+				}
+			}
+		case *ast.String:
+			if entering {
+				current.Write(node.Value)
+			}
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			if entering {
+				segments := node.Lines()
+				for i := 0; i < segments.Len(); i++ {
+					segment := segments.At(i)
+					current.Write(segment.Value(src))
+				}
+			}
+		case *ast.AutoLink:
+			if entering {
+				current.Write(node.Label(src))
+			}
+		}
+
+		if !entering && n.Type() == ast.TypeBlock && current.Len() > 0 {
+			blocks = append(blocks, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.Join(blocks, "\n")
+}
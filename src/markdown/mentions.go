@@ -0,0 +1,79 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// handlePattern matches a mention at the start of a line. Handles end in a letter or digit,
+// so the period closing a sentence is not part of the handle
+var handlePattern = regexp.MustCompile(`^@([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)`)
+
+var mentionsKey = parser.NewContextKey()
+
+// mentionState collects the handles found while parsing and holds the profiles to link them to
+type mentionState struct {
+	profiles map[string]string
+	found    []string
+	seen     map[string]bool
+}
+
+// mentionParser parses @handle outside of code. Mentions of handles with a profile
+// become links to it and the others are left as text
+type mentionParser struct{}
+
+var mentions = util.Prioritized(mentionParser{}, 500)
+
+func (mentionParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+func (mentionParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	state, ok := pc.Get(mentionsKey).(*mentionState)
+	if !ok {
+		return nil
+	}
+	// An @ inside a word, an email address or a path is not a mention
+	prev := block.PrecendingCharacter()
+	if unicode.IsLetter(prev) || unicode.IsDigit(prev) || strings.ContainsRune("_.-@/", prev) {
+		return nil
+	}
+
+	line, segment := block.PeekLine()
+	match := handlePattern.FindSubmatch(line)
+	if match == nil {
+		return nil
+	}
+	handle := strings.ToLower(string(match[1]))
+	if !state.seen[handle] {
+		state.seen[handle] = true
+		state.found = append(state.found, handle)
+	}
+
+	profile, ok := state.profiles[handle]
+	if !ok {
+		return nil
+	}
+	block.Advance(len(match[0]))
+	link := ast.NewLink()
+	link.Destination = []byte(profile)
+	link.SetAttributeString("class", []byte("mention"))
+	link.AppendChild(link, ast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+len(match[0]))))
+	return link
+}
+
+// Mentions returns the lowercased handles mentioned with @handle in a document,
+// in order of first appearance. Mentions in code are ignored
+func Mentions(source string) []string {
+	state := &mentionState{seen: map[string]bool{}}
+	ctx := parser.NewContext()
+	ctx.Set(mentionsKey, state)
+	converter.Parser().Parse(text.NewReader([]byte(source)), parser.WithContext(ctx))
+	return state.found
+}
@@ -33,9 +33,42 @@ func VerifyUser(pool *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		verifiedToken, _ := VerifyToken(token)
-		username := extractUsername(verifiedToken)
-		c.Set("username", username)
+		setUser(c, token)
+	}
+}
+
+// IdentifyUser sets the user like VerifyUser when the request carries a valid access_token
+// and lets anonymous requests through
+func IdentifyUser(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := c.Cookie("access_token")
+		if err != nil {
+			return
+		}
+
+		if err := ValidateToken(c, token, pool); err == nil {
+			setUser(c, token)
+		}
+	}
+}
+
+func setUser(c *gin.Context, token string) {
+	verifiedToken, _ := VerifyToken(token)
+	username := extractUsername(verifiedToken)
+	c.Set("username", username)
+	c.Set("email", extractEmail(verifiedToken))
+}
+
+// VerifyAdmin allows only admins through. It must run after VerifyUser
+func VerifyAdmin(pool *sql.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		email, _ := c.Get("email")
+		user, err := db.GetUserByEmail(c, pool, fmt.Sprint(email))
+		if err != nil || !user.IsAdmin {
+			api.HandleError(c, http.StatusForbidden, "User is not an admin.")
+			c.Abort()
+			return
+		}
 	}
 }
 
@@ -93,7 +126,11 @@ func ValidateToken(c context.Context, t string, pool *sql.DB) error {
 }
 
 func extractUsername(t *jwt.Token) string {
+	return strings.Split(extractEmail(t), "@")[0]
+}
+
+func extractEmail(t *jwt.Token) string {
 	claims, _ := t.Claims.(jwt.MapClaims)
 	email, _ := claims["user_email"]
-	return strings.Split(email.(string), "@")[0]
+	return email.(string)
 }
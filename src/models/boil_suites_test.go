@@ -12,103 +12,730 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentions)
 	t.Run("GorpMigrations", testGorpMigrations)
+	t.Run("Highlights", testHighlights)
+	t.Run("MeteredReads", testMeteredReads)
+	t.Run("NotificationActors", testNotificationActors)
+	t.Run("NotificationPreferences", testNotificationPreferences)
+	t.Run("Notifications", testNotifications)
+	t.Run("PostAuthors", testPostAuthors)
+	t.Run("PostLikes", testPostLikes)
+	t.Run("PostMentions", testPostMentions)
+	t.Run("PostRankings", testPostRankings)
+	t.Run("PostRevisions", testPostRevisions)
+	t.Run("PostTags", testPostTags)
+	t.Run("PostViews", testPostViews)
 	t.Run("Posts", testPosts)
+	t.Run("PublicationMembers", testPublicationMembers)
+	t.Run("Publications", testPublications)
+	t.Run("ReadingListPosts", testReadingListPosts)
+	t.Run("ReadingLists", testReadingLists)
+	t.Run("RelatedPosts", testRelatedPosts)
+	t.Run("AllSeries", testAllSeries)
+	t.Run("SeriesPosts", testSeriesPosts)
+	t.Run("ShareLinkAccesses", testShareLinkAccesses)
+	t.Run("ShareLinkComments", testShareLinkComments)
+	t.Run("ShareLinks", testShareLinks)
+	t.Run("Submissions", testSubmissions)
+	t.Run("Subscriptions", testSubscriptions)
+	t.Run("TagAliases", testTagAliases)
+	t.Run("TagFollows", testTagFollows)
+	t.Run("Tags", testTags)
+	t.Run("UploadRefs", testUploadRefs)
+	t.Run("UploadVariants", testUploadVariants)
+	t.Run("Uploads", testUploads)
+	t.Run("UserBlocks", testUserBlocks)
+	t.Run("UserFollows", testUserFollows)
+	t.Run("UserUploadRefs", testUserUploadRefs)
 	t.Run("Users", testUsers)
 }
 
+func TestSoftDelete(t *testing.T) {
+	t.Run("Posts", testPostsSoftDelete)
+}
+
+func TestQuerySoftDeleteAll(t *testing.T) {
+	t.Run("Posts", testPostsQuerySoftDeleteAll)
+}
+
+func TestSliceSoftDeleteAll(t *testing.T) {
+	t.Run("Posts", testPostsSliceSoftDeleteAll)
+}
+
 func TestDelete(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsDelete)
 	t.Run("GorpMigrations", testGorpMigrationsDelete)
+	t.Run("Highlights", testHighlightsDelete)
+	t.Run("MeteredReads", testMeteredReadsDelete)
+	t.Run("NotificationActors", testNotificationActorsDelete)
+	t.Run("NotificationPreferences", testNotificationPreferencesDelete)
+	t.Run("Notifications", testNotificationsDelete)
+	t.Run("PostAuthors", testPostAuthorsDelete)
+	t.Run("PostLikes", testPostLikesDelete)
+	t.Run("PostMentions", testPostMentionsDelete)
+	t.Run("PostRankings", testPostRankingsDelete)
+	t.Run("PostRevisions", testPostRevisionsDelete)
+	t.Run("PostTags", testPostTagsDelete)
+	t.Run("PostViews", testPostViewsDelete)
 	t.Run("Posts", testPostsDelete)
+	t.Run("PublicationMembers", testPublicationMembersDelete)
+	t.Run("Publications", testPublicationsDelete)
+	t.Run("ReadingListPosts", testReadingListPostsDelete)
+	t.Run("ReadingLists", testReadingListsDelete)
+	t.Run("RelatedPosts", testRelatedPostsDelete)
+	t.Run("AllSeries", testAllSeriesDelete)
+	t.Run("SeriesPosts", testSeriesPostsDelete)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesDelete)
+	t.Run("ShareLinkComments", testShareLinkCommentsDelete)
+	t.Run("ShareLinks", testShareLinksDelete)
+	t.Run("Submissions", testSubmissionsDelete)
+	t.Run("Subscriptions", testSubscriptionsDelete)
+	t.Run("TagAliases", testTagAliasesDelete)
+	t.Run("TagFollows", testTagFollowsDelete)
+	t.Run("Tags", testTagsDelete)
+	t.Run("UploadRefs", testUploadRefsDelete)
+	t.Run("UploadVariants", testUploadVariantsDelete)
+	t.Run("Uploads", testUploadsDelete)
+	t.Run("UserBlocks", testUserBlocksDelete)
+	t.Run("UserFollows", testUserFollowsDelete)
+	t.Run("UserUploadRefs", testUserUploadRefsDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsQueryDeleteAll)
 	t.Run("GorpMigrations", testGorpMigrationsQueryDeleteAll)
+	t.Run("Highlights", testHighlightsQueryDeleteAll)
+	t.Run("MeteredReads", testMeteredReadsQueryDeleteAll)
+	t.Run("NotificationActors", testNotificationActorsQueryDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesQueryDeleteAll)
+	t.Run("Notifications", testNotificationsQueryDeleteAll)
+	t.Run("PostAuthors", testPostAuthorsQueryDeleteAll)
+	t.Run("PostLikes", testPostLikesQueryDeleteAll)
+	t.Run("PostMentions", testPostMentionsQueryDeleteAll)
+	t.Run("PostRankings", testPostRankingsQueryDeleteAll)
+	t.Run("PostRevisions", testPostRevisionsQueryDeleteAll)
+	t.Run("PostTags", testPostTagsQueryDeleteAll)
+	t.Run("PostViews", testPostViewsQueryDeleteAll)
 	t.Run("Posts", testPostsQueryDeleteAll)
+	t.Run("PublicationMembers", testPublicationMembersQueryDeleteAll)
+	t.Run("Publications", testPublicationsQueryDeleteAll)
+	t.Run("ReadingListPosts", testReadingListPostsQueryDeleteAll)
+	t.Run("ReadingLists", testReadingListsQueryDeleteAll)
+	t.Run("RelatedPosts", testRelatedPostsQueryDeleteAll)
+	t.Run("AllSeries", testAllSeriesQueryDeleteAll)
+	t.Run("SeriesPosts", testSeriesPostsQueryDeleteAll)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesQueryDeleteAll)
+	t.Run("ShareLinkComments", testShareLinkCommentsQueryDeleteAll)
+	t.Run("ShareLinks", testShareLinksQueryDeleteAll)
+	t.Run("Submissions", testSubmissionsQueryDeleteAll)
+	t.Run("Subscriptions", testSubscriptionsQueryDeleteAll)
+	t.Run("TagAliases", testTagAliasesQueryDeleteAll)
+	t.Run("TagFollows", testTagFollowsQueryDeleteAll)
+	t.Run("Tags", testTagsQueryDeleteAll)
+	t.Run("UploadRefs", testUploadRefsQueryDeleteAll)
+	t.Run("UploadVariants", testUploadVariantsQueryDeleteAll)
+	t.Run("Uploads", testUploadsQueryDeleteAll)
+	t.Run("UserBlocks", testUserBlocksQueryDeleteAll)
+	t.Run("UserFollows", testUserFollowsQueryDeleteAll)
+	t.Run("UserUploadRefs", testUserUploadRefsQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsSliceDeleteAll)
 	t.Run("GorpMigrations", testGorpMigrationsSliceDeleteAll)
+	t.Run("Highlights", testHighlightsSliceDeleteAll)
+	t.Run("MeteredReads", testMeteredReadsSliceDeleteAll)
+	t.Run("NotificationActors", testNotificationActorsSliceDeleteAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceDeleteAll)
+	t.Run("Notifications", testNotificationsSliceDeleteAll)
+	t.Run("PostAuthors", testPostAuthorsSliceDeleteAll)
+	t.Run("PostLikes", testPostLikesSliceDeleteAll)
+	t.Run("PostMentions", testPostMentionsSliceDeleteAll)
+	t.Run("PostRankings", testPostRankingsSliceDeleteAll)
+	t.Run("PostRevisions", testPostRevisionsSliceDeleteAll)
+	t.Run("PostTags", testPostTagsSliceDeleteAll)
+	t.Run("PostViews", testPostViewsSliceDeleteAll)
 	t.Run("Posts", testPostsSliceDeleteAll)
+	t.Run("PublicationMembers", testPublicationMembersSliceDeleteAll)
+	t.Run("Publications", testPublicationsSliceDeleteAll)
+	t.Run("ReadingListPosts", testReadingListPostsSliceDeleteAll)
+	t.Run("ReadingLists", testReadingListsSliceDeleteAll)
+	t.Run("RelatedPosts", testRelatedPostsSliceDeleteAll)
+	t.Run("AllSeries", testAllSeriesSliceDeleteAll)
+	t.Run("SeriesPosts", testSeriesPostsSliceDeleteAll)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesSliceDeleteAll)
+	t.Run("ShareLinkComments", testShareLinkCommentsSliceDeleteAll)
+	t.Run("ShareLinks", testShareLinksSliceDeleteAll)
+	t.Run("Submissions", testSubmissionsSliceDeleteAll)
+	t.Run("Subscriptions", testSubscriptionsSliceDeleteAll)
+	t.Run("TagAliases", testTagAliasesSliceDeleteAll)
+	t.Run("TagFollows", testTagFollowsSliceDeleteAll)
+	t.Run("Tags", testTagsSliceDeleteAll)
+	t.Run("UploadRefs", testUploadRefsSliceDeleteAll)
+	t.Run("UploadVariants", testUploadVariantsSliceDeleteAll)
+	t.Run("Uploads", testUploadsSliceDeleteAll)
+	t.Run("UserBlocks", testUserBlocksSliceDeleteAll)
+	t.Run("UserFollows", testUserFollowsSliceDeleteAll)
+	t.Run("UserUploadRefs", testUserUploadRefsSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsExists)
 	t.Run("GorpMigrations", testGorpMigrationsExists)
+	t.Run("Highlights", testHighlightsExists)
+	t.Run("MeteredReads", testMeteredReadsExists)
+	t.Run("NotificationActors", testNotificationActorsExists)
+	t.Run("NotificationPreferences", testNotificationPreferencesExists)
+	t.Run("Notifications", testNotificationsExists)
+	t.Run("PostAuthors", testPostAuthorsExists)
+	t.Run("PostLikes", testPostLikesExists)
+	t.Run("PostMentions", testPostMentionsExists)
+	t.Run("PostRankings", testPostRankingsExists)
+	t.Run("PostRevisions", testPostRevisionsExists)
+	t.Run("PostTags", testPostTagsExists)
+	t.Run("PostViews", testPostViewsExists)
 	t.Run("Posts", testPostsExists)
+	t.Run("PublicationMembers", testPublicationMembersExists)
+	t.Run("Publications", testPublicationsExists)
+	t.Run("ReadingListPosts", testReadingListPostsExists)
+	t.Run("ReadingLists", testReadingListsExists)
+	t.Run("RelatedPosts", testRelatedPostsExists)
+	t.Run("AllSeries", testAllSeriesExists)
+	t.Run("SeriesPosts", testSeriesPostsExists)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesExists)
+	t.Run("ShareLinkComments", testShareLinkCommentsExists)
+	t.Run("ShareLinks", testShareLinksExists)
+	t.Run("Submissions", testSubmissionsExists)
+	t.Run("Subscriptions", testSubscriptionsExists)
+	t.Run("TagAliases", testTagAliasesExists)
+	t.Run("TagFollows", testTagFollowsExists)
+	t.Run("Tags", testTagsExists)
+	t.Run("UploadRefs", testUploadRefsExists)
+	t.Run("UploadVariants", testUploadVariantsExists)
+	t.Run("Uploads", testUploadsExists)
+	t.Run("UserBlocks", testUserBlocksExists)
+	t.Run("UserFollows", testUserFollowsExists)
+	t.Run("UserUploadRefs", testUserUploadRefsExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsFind)
 	t.Run("GorpMigrations", testGorpMigrationsFind)
+	t.Run("Highlights", testHighlightsFind)
+	t.Run("MeteredReads", testMeteredReadsFind)
+	t.Run("NotificationActors", testNotificationActorsFind)
+	t.Run("NotificationPreferences", testNotificationPreferencesFind)
+	t.Run("Notifications", testNotificationsFind)
+	t.Run("PostAuthors", testPostAuthorsFind)
+	t.Run("PostLikes", testPostLikesFind)
+	t.Run("PostMentions", testPostMentionsFind)
+	t.Run("PostRankings", testPostRankingsFind)
+	t.Run("PostRevisions", testPostRevisionsFind)
+	t.Run("PostTags", testPostTagsFind)
+	t.Run("PostViews", testPostViewsFind)
 	t.Run("Posts", testPostsFind)
+	t.Run("PublicationMembers", testPublicationMembersFind)
+	t.Run("Publications", testPublicationsFind)
+	t.Run("ReadingListPosts", testReadingListPostsFind)
+	t.Run("ReadingLists", testReadingListsFind)
+	t.Run("RelatedPosts", testRelatedPostsFind)
+	t.Run("AllSeries", testAllSeriesFind)
+	t.Run("SeriesPosts", testSeriesPostsFind)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesFind)
+	t.Run("ShareLinkComments", testShareLinkCommentsFind)
+	t.Run("ShareLinks", testShareLinksFind)
+	t.Run("Submissions", testSubmissionsFind)
+	t.Run("Subscriptions", testSubscriptionsFind)
+	t.Run("TagAliases", testTagAliasesFind)
+	t.Run("TagFollows", testTagFollowsFind)
+	t.Run("Tags", testTagsFind)
+	t.Run("UploadRefs", testUploadRefsFind)
+	t.Run("UploadVariants", testUploadVariantsFind)
+	t.Run("Uploads", testUploadsFind)
+	t.Run("UserBlocks", testUserBlocksFind)
+	t.Run("UserFollows", testUserFollowsFind)
+	t.Run("UserUploadRefs", testUserUploadRefsFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsBind)
 	t.Run("GorpMigrations", testGorpMigrationsBind)
+	t.Run("Highlights", testHighlightsBind)
+	t.Run("MeteredReads", testMeteredReadsBind)
+	t.Run("NotificationActors", testNotificationActorsBind)
+	t.Run("NotificationPreferences", testNotificationPreferencesBind)
+	t.Run("Notifications", testNotificationsBind)
+	t.Run("PostAuthors", testPostAuthorsBind)
+	t.Run("PostLikes", testPostLikesBind)
+	t.Run("PostMentions", testPostMentionsBind)
+	t.Run("PostRankings", testPostRankingsBind)
+	t.Run("PostRevisions", testPostRevisionsBind)
+	t.Run("PostTags", testPostTagsBind)
+	t.Run("PostViews", testPostViewsBind)
 	t.Run("Posts", testPostsBind)
+	t.Run("PublicationMembers", testPublicationMembersBind)
+	t.Run("Publications", testPublicationsBind)
+	t.Run("ReadingListPosts", testReadingListPostsBind)
+	t.Run("ReadingLists", testReadingListsBind)
+	t.Run("RelatedPosts", testRelatedPostsBind)
+	t.Run("AllSeries", testAllSeriesBind)
+	t.Run("SeriesPosts", testSeriesPostsBind)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesBind)
+	t.Run("ShareLinkComments", testShareLinkCommentsBind)
+	t.Run("ShareLinks", testShareLinksBind)
+	t.Run("Submissions", testSubmissionsBind)
+	t.Run("Subscriptions", testSubscriptionsBind)
+	t.Run("TagAliases", testTagAliasesBind)
+	t.Run("TagFollows", testTagFollowsBind)
+	t.Run("Tags", testTagsBind)
+	t.Run("UploadRefs", testUploadRefsBind)
+	t.Run("UploadVariants", testUploadVariantsBind)
+	t.Run("Uploads", testUploadsBind)
+	t.Run("UserBlocks", testUserBlocksBind)
+	t.Run("UserFollows", testUserFollowsBind)
+	t.Run("UserUploadRefs", testUserUploadRefsBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsOne)
 	t.Run("GorpMigrations", testGorpMigrationsOne)
+	t.Run("Highlights", testHighlightsOne)
+	t.Run("MeteredReads", testMeteredReadsOne)
+	t.Run("NotificationActors", testNotificationActorsOne)
+	t.Run("NotificationPreferences", testNotificationPreferencesOne)
+	t.Run("Notifications", testNotificationsOne)
+	t.Run("PostAuthors", testPostAuthorsOne)
+	t.Run("PostLikes", testPostLikesOne)
+	t.Run("PostMentions", testPostMentionsOne)
+	t.Run("PostRankings", testPostRankingsOne)
+	t.Run("PostRevisions", testPostRevisionsOne)
+	t.Run("PostTags", testPostTagsOne)
+	t.Run("PostViews", testPostViewsOne)
 	t.Run("Posts", testPostsOne)
+	t.Run("PublicationMembers", testPublicationMembersOne)
+	t.Run("Publications", testPublicationsOne)
+	t.Run("ReadingListPosts", testReadingListPostsOne)
+	t.Run("ReadingLists", testReadingListsOne)
+	t.Run("RelatedPosts", testRelatedPostsOne)
+	t.Run("AllSeries", testAllSeriesOne)
+	t.Run("SeriesPosts", testSeriesPostsOne)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesOne)
+	t.Run("ShareLinkComments", testShareLinkCommentsOne)
+	t.Run("ShareLinks", testShareLinksOne)
+	t.Run("Submissions", testSubmissionsOne)
+	t.Run("Subscriptions", testSubscriptionsOne)
+	t.Run("TagAliases", testTagAliasesOne)
+	t.Run("TagFollows", testTagFollowsOne)
+	t.Run("Tags", testTagsOne)
+	t.Run("UploadRefs", testUploadRefsOne)
+	t.Run("UploadVariants", testUploadVariantsOne)
+	t.Run("Uploads", testUploadsOne)
+	t.Run("UserBlocks", testUserBlocksOne)
+	t.Run("UserFollows", testUserFollowsOne)
+	t.Run("UserUploadRefs", testUserUploadRefsOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsAll)
 	t.Run("GorpMigrations", testGorpMigrationsAll)
+	t.Run("Highlights", testHighlightsAll)
+	t.Run("MeteredReads", testMeteredReadsAll)
+	t.Run("NotificationActors", testNotificationActorsAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesAll)
+	t.Run("Notifications", testNotificationsAll)
+	t.Run("PostAuthors", testPostAuthorsAll)
+	t.Run("PostLikes", testPostLikesAll)
+	t.Run("PostMentions", testPostMentionsAll)
+	t.Run("PostRankings", testPostRankingsAll)
+	t.Run("PostRevisions", testPostRevisionsAll)
+	t.Run("PostTags", testPostTagsAll)
+	t.Run("PostViews", testPostViewsAll)
 	t.Run("Posts", testPostsAll)
+	t.Run("PublicationMembers", testPublicationMembersAll)
+	t.Run("Publications", testPublicationsAll)
+	t.Run("ReadingListPosts", testReadingListPostsAll)
+	t.Run("ReadingLists", testReadingListsAll)
+	t.Run("RelatedPosts", testRelatedPostsAll)
+	t.Run("AllSeries", testAllSeriesAll)
+	t.Run("SeriesPosts", testSeriesPostsAll)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesAll)
+	t.Run("ShareLinkComments", testShareLinkCommentsAll)
+	t.Run("ShareLinks", testShareLinksAll)
+	t.Run("Submissions", testSubmissionsAll)
+	t.Run("Subscriptions", testSubscriptionsAll)
+	t.Run("TagAliases", testTagAliasesAll)
+	t.Run("TagFollows", testTagFollowsAll)
+	t.Run("Tags", testTagsAll)
+	t.Run("UploadRefs", testUploadRefsAll)
+	t.Run("UploadVariants", testUploadVariantsAll)
+	t.Run("Uploads", testUploadsAll)
+	t.Run("UserBlocks", testUserBlocksAll)
+	t.Run("UserFollows", testUserFollowsAll)
+	t.Run("UserUploadRefs", testUserUploadRefsAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsCount)
 	t.Run("GorpMigrations", testGorpMigrationsCount)
+	t.Run("Highlights", testHighlightsCount)
+	t.Run("MeteredReads", testMeteredReadsCount)
+	t.Run("NotificationActors", testNotificationActorsCount)
+	t.Run("NotificationPreferences", testNotificationPreferencesCount)
+	t.Run("Notifications", testNotificationsCount)
+	t.Run("PostAuthors", testPostAuthorsCount)
+	t.Run("PostLikes", testPostLikesCount)
+	t.Run("PostMentions", testPostMentionsCount)
+	t.Run("PostRankings", testPostRankingsCount)
+	t.Run("PostRevisions", testPostRevisionsCount)
+	t.Run("PostTags", testPostTagsCount)
+	t.Run("PostViews", testPostViewsCount)
 	t.Run("Posts", testPostsCount)
+	t.Run("PublicationMembers", testPublicationMembersCount)
+	t.Run("Publications", testPublicationsCount)
+	t.Run("ReadingListPosts", testReadingListPostsCount)
+	t.Run("ReadingLists", testReadingListsCount)
+	t.Run("RelatedPosts", testRelatedPostsCount)
+	t.Run("AllSeries", testAllSeriesCount)
+	t.Run("SeriesPosts", testSeriesPostsCount)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesCount)
+	t.Run("ShareLinkComments", testShareLinkCommentsCount)
+	t.Run("ShareLinks", testShareLinksCount)
+	t.Run("Submissions", testSubmissionsCount)
+	t.Run("Subscriptions", testSubscriptionsCount)
+	t.Run("TagAliases", testTagAliasesCount)
+	t.Run("TagFollows", testTagFollowsCount)
+	t.Run("Tags", testTagsCount)
+	t.Run("UploadRefs", testUploadRefsCount)
+	t.Run("UploadVariants", testUploadVariantsCount)
+	t.Run("Uploads", testUploadsCount)
+	t.Run("UserBlocks", testUserBlocksCount)
+	t.Run("UserFollows", testUserFollowsCount)
+	t.Run("UserUploadRefs", testUserUploadRefsCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsHooks)
 	t.Run("GorpMigrations", testGorpMigrationsHooks)
+	t.Run("Highlights", testHighlightsHooks)
+	t.Run("MeteredReads", testMeteredReadsHooks)
+	t.Run("NotificationActors", testNotificationActorsHooks)
+	t.Run("NotificationPreferences", testNotificationPreferencesHooks)
+	t.Run("Notifications", testNotificationsHooks)
+	t.Run("PostAuthors", testPostAuthorsHooks)
+	t.Run("PostLikes", testPostLikesHooks)
+	t.Run("PostMentions", testPostMentionsHooks)
+	t.Run("PostRankings", testPostRankingsHooks)
+	t.Run("PostRevisions", testPostRevisionsHooks)
+	t.Run("PostTags", testPostTagsHooks)
+	t.Run("PostViews", testPostViewsHooks)
 	t.Run("Posts", testPostsHooks)
+	t.Run("PublicationMembers", testPublicationMembersHooks)
+	t.Run("Publications", testPublicationsHooks)
+	t.Run("ReadingListPosts", testReadingListPostsHooks)
+	t.Run("ReadingLists", testReadingListsHooks)
+	t.Run("RelatedPosts", testRelatedPostsHooks)
+	t.Run("AllSeries", testAllSeriesHooks)
+	t.Run("SeriesPosts", testSeriesPostsHooks)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesHooks)
+	t.Run("ShareLinkComments", testShareLinkCommentsHooks)
+	t.Run("ShareLinks", testShareLinksHooks)
+	t.Run("Submissions", testSubmissionsHooks)
+	t.Run("Subscriptions", testSubscriptionsHooks)
+	t.Run("TagAliases", testTagAliasesHooks)
+	t.Run("TagFollows", testTagFollowsHooks)
+	t.Run("Tags", testTagsHooks)
+	t.Run("UploadRefs", testUploadRefsHooks)
+	t.Run("UploadVariants", testUploadVariantsHooks)
+	t.Run("Uploads", testUploadsHooks)
+	t.Run("UserBlocks", testUserBlocksHooks)
+	t.Run("UserFollows", testUserFollowsHooks)
+	t.Run("UserUploadRefs", testUserUploadRefsHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsInsert)
+	t.Run("CommentMentions", testCommentMentionsInsertWhitelist)
 	t.Run("GorpMigrations", testGorpMigrationsInsert)
 	t.Run("GorpMigrations", testGorpMigrationsInsertWhitelist)
+	t.Run("Highlights", testHighlightsInsert)
+	t.Run("Highlights", testHighlightsInsertWhitelist)
+	t.Run("MeteredReads", testMeteredReadsInsert)
+	t.Run("MeteredReads", testMeteredReadsInsertWhitelist)
+	t.Run("NotificationActors", testNotificationActorsInsert)
+	t.Run("NotificationActors", testNotificationActorsInsertWhitelist)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsert)
+	t.Run("NotificationPreferences", testNotificationPreferencesInsertWhitelist)
+	t.Run("Notifications", testNotificationsInsert)
+	t.Run("Notifications", testNotificationsInsertWhitelist)
+	t.Run("PostAuthors", testPostAuthorsInsert)
+	t.Run("PostAuthors", testPostAuthorsInsertWhitelist)
+	t.Run("PostLikes", testPostLikesInsert)
+	t.Run("PostLikes", testPostLikesInsertWhitelist)
+	t.Run("PostMentions", testPostMentionsInsert)
+	t.Run("PostMentions", testPostMentionsInsertWhitelist)
+	t.Run("PostRankings", testPostRankingsInsert)
+	t.Run("PostRankings", testPostRankingsInsertWhitelist)
+	t.Run("PostRevisions", testPostRevisionsInsert)
+	t.Run("PostRevisions", testPostRevisionsInsertWhitelist)
+	t.Run("PostTags", testPostTagsInsert)
+	t.Run("PostTags", testPostTagsInsertWhitelist)
+	t.Run("PostViews", testPostViewsInsert)
+	t.Run("PostViews", testPostViewsInsertWhitelist)
 	t.Run("Posts", testPostsInsert)
 	t.Run("Posts", testPostsInsertWhitelist)
+	t.Run("PublicationMembers", testPublicationMembersInsert)
+	t.Run("PublicationMembers", testPublicationMembersInsertWhitelist)
+	t.Run("Publications", testPublicationsInsert)
+	t.Run("Publications", testPublicationsInsertWhitelist)
+	t.Run("ReadingListPosts", testReadingListPostsInsert)
+	t.Run("ReadingListPosts", testReadingListPostsInsertWhitelist)
+	t.Run("ReadingLists", testReadingListsInsert)
+	t.Run("ReadingLists", testReadingListsInsertWhitelist)
+	t.Run("RelatedPosts", testRelatedPostsInsert)
+	t.Run("RelatedPosts", testRelatedPostsInsertWhitelist)
+	t.Run("AllSeries", testAllSeriesInsert)
+	t.Run("AllSeries", testAllSeriesInsertWhitelist)
+	t.Run("SeriesPosts", testSeriesPostsInsert)
+	t.Run("SeriesPosts", testSeriesPostsInsertWhitelist)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesInsert)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesInsertWhitelist)
+	t.Run("ShareLinkComments", testShareLinkCommentsInsert)
+	t.Run("ShareLinkComments", testShareLinkCommentsInsertWhitelist)
+	t.Run("ShareLinks", testShareLinksInsert)
+	t.Run("ShareLinks", testShareLinksInsertWhitelist)
+	t.Run("Submissions", testSubmissionsInsert)
+	t.Run("Submissions", testSubmissionsInsertWhitelist)
+	t.Run("Subscriptions", testSubscriptionsInsert)
+	t.Run("Subscriptions", testSubscriptionsInsertWhitelist)
+	t.Run("TagAliases", testTagAliasesInsert)
+	t.Run("TagAliases", testTagAliasesInsertWhitelist)
+	t.Run("TagFollows", testTagFollowsInsert)
+	t.Run("TagFollows", testTagFollowsInsertWhitelist)
+	t.Run("Tags", testTagsInsert)
+	t.Run("Tags", testTagsInsertWhitelist)
+	t.Run("UploadRefs", testUploadRefsInsert)
+	t.Run("UploadRefs", testUploadRefsInsertWhitelist)
+	t.Run("UploadVariants", testUploadVariantsInsert)
+	t.Run("UploadVariants", testUploadVariantsInsertWhitelist)
+	t.Run("Uploads", testUploadsInsert)
+	t.Run("Uploads", testUploadsInsertWhitelist)
+	t.Run("UserBlocks", testUserBlocksInsert)
+	t.Run("UserBlocks", testUserBlocksInsertWhitelist)
+	t.Run("UserFollows", testUserFollowsInsert)
+	t.Run("UserFollows", testUserFollowsInsertWhitelist)
+	t.Run("UserUploadRefs", testUserUploadRefsInsert)
+	t.Run("UserUploadRefs", testUserUploadRefsInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("CommentMentionToShareLinkCommentUsingComment", testCommentMentionToOneShareLinkCommentUsingComment)
+	t.Run("CommentMentionToUserUsingUser", testCommentMentionToOneUserUsingUser)
+	t.Run("HighlightToPostUsingPost", testHighlightToOnePostUsingPost)
+	t.Run("HighlightToUserUsingUser", testHighlightToOneUserUsingUser)
+	t.Run("MeteredReadToUserUsingUser", testMeteredReadToOneUserUsingUser)
+	t.Run("MeteredReadToPostUsingPost", testMeteredReadToOnePostUsingPost)
+	t.Run("NotificationActorToNotificationUsingNotification", testNotificationActorToOneNotificationUsingNotification)
+	t.Run("NotificationActorToUserUsingActor", testNotificationActorToOneUserUsingActor)
+	t.Run("NotificationPreferenceToUserUsingUser", testNotificationPreferenceToOneUserUsingUser)
+	t.Run("NotificationToUserUsingUser", testNotificationToOneUserUsingUser)
+	t.Run("NotificationToPostUsingPost", testNotificationToOnePostUsingPost)
+	t.Run("NotificationToUserUsingActor", testNotificationToOneUserUsingActor)
+	t.Run("PostAuthorToPostUsingPost", testPostAuthorToOnePostUsingPost)
+	t.Run("PostAuthorToUserUsingUser", testPostAuthorToOneUserUsingUser)
+	t.Run("PostLikeToUserUsingUser", testPostLikeToOneUserUsingUser)
+	t.Run("PostLikeToPostUsingPost", testPostLikeToOnePostUsingPost)
+	t.Run("PostMentionToPostUsingPost", testPostMentionToOnePostUsingPost)
+	t.Run("PostMentionToUserUsingUser", testPostMentionToOneUserUsingUser)
+	t.Run("PostRankingToPostUsingPost", testPostRankingToOnePostUsingPost)
+	t.Run("PostRevisionToPostUsingPost", testPostRevisionToOnePostUsingPost)
+	t.Run("PostTagToPostUsingPost", testPostTagToOnePostUsingPost)
+	t.Run("PostTagToTagUsingTag", testPostTagToOneTagUsingTag)
+	t.Run("PostViewToPostUsingPost", testPostViewToOnePostUsingPost)
+	t.Run("PostToPublicationUsingPublication", testPostToOnePublicationUsingPublication)
+	t.Run("PublicationMemberToPublicationUsingPublication", testPublicationMemberToOnePublicationUsingPublication)
+	t.Run("PublicationMemberToUserUsingUser", testPublicationMemberToOneUserUsingUser)
+	t.Run("ReadingListPostToReadingListUsingList", testReadingListPostToOneReadingListUsingList)
+	t.Run("ReadingListPostToPostUsingPost", testReadingListPostToOnePostUsingPost)
+	t.Run("ReadingListToUserUsingUser", testReadingListToOneUserUsingUser)
+	t.Run("RelatedPostToPostUsingPost", testRelatedPostToOnePostUsingPost)
+	t.Run("SeriesPostToSeriesUsingSeries", testSeriesPostToOneSeriesUsingSeries)
+	t.Run("SeriesPostToPostUsingPost", testSeriesPostToOnePostUsingPost)
+	t.Run("ShareLinkAccessToShareLinkUsingLink", testShareLinkAccessToOneShareLinkUsingLink)
+	t.Run("ShareLinkCommentToShareLinkUsingLink", testShareLinkCommentToOneShareLinkUsingLink)
+	t.Run("ShareLinkCommentToShareLinkCommentUsingParent", testShareLinkCommentToOneShareLinkCommentUsingParent)
+	t.Run("ShareLinkCommentToUserUsingUser", testShareLinkCommentToOneUserUsingUser)
+	t.Run("ShareLinkToPostUsingPost", testShareLinkToOnePostUsingPost)
+	t.Run("SubmissionToPublicationUsingPublication", testSubmissionToOnePublicationUsingPublication)
+	t.Run("SubmissionToPostUsingPost", testSubmissionToOnePostUsingPost)
+	t.Run("SubmissionToUserUsingSubmittedByUser", testSubmissionToOneUserUsingSubmittedByUser)
+	t.Run("SubmissionToUserUsingReviewedByUser", testSubmissionToOneUserUsingReviewedByUser)
+	t.Run("SubscriptionToUserUsingUser", testSubscriptionToOneUserUsingUser)
+	t.Run("TagAliasToTagUsingTag", testTagAliasToOneTagUsingTag)
+	t.Run("TagFollowToUserUsingUser", testTagFollowToOneUserUsingUser)
+	t.Run("TagFollowToTagUsingTag", testTagFollowToOneTagUsingTag)
+	t.Run("UploadRefToPostUsingPost", testUploadRefToOnePostUsingPost)
+	t.Run("UploadVariantToUploadUsingUpload", testUploadVariantToOneUploadUsingUpload)
+	t.Run("UploadToUserUsingUser", testUploadToOneUserUsingUser)
+	t.Run("UserBlockToUserUsingBlocker", testUserBlockToOneUserUsingBlocker)
+	t.Run("UserBlockToUserUsingBlocked", testUserBlockToOneUserUsingBlocked)
+	t.Run("UserFollowToUserUsingFollower", testUserFollowToOneUserUsingFollower)
+	t.Run("UserFollowToUserUsingFollowee", testUserFollowToOneUserUsingFollowee)
+	t.Run("UserUploadRefToUserUsingUser", testUserUploadRefToOneUserUsingUser)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("PostToSeriesPostUsingSeriesPost", testPostOneToOneSeriesPostUsingSeriesPost)
+	t.Run("UserToSubscriptionUsingSubscription", testUserOneToOneSubscriptionUsingSubscription)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("NotificationToNotificationActors", testNotificationToManyNotificationActors)
+	t.Run("PostToHighlights", testPostToManyHighlights)
+	t.Run("PostToMeteredReads", testPostToManyMeteredReads)
+	t.Run("PostToNotifications", testPostToManyNotifications)
+	t.Run("PostToPostAuthors", testPostToManyPostAuthors)
+	t.Run("PostToPostLikes", testPostToManyPostLikes)
+	t.Run("PostToPostMentions", testPostToManyPostMentions)
+	t.Run("PostToPostRankings", testPostToManyPostRankings)
+	t.Run("PostToPostRevisions", testPostToManyPostRevisions)
+	t.Run("PostToPostTags", testPostToManyPostTags)
+	t.Run("PostToPostViews", testPostToManyPostViews)
+	t.Run("PostToReadingListPosts", testPostToManyReadingListPosts)
+	t.Run("PostToRelatedPosts", testPostToManyRelatedPosts)
+	t.Run("PostToShareLinks", testPostToManyShareLinks)
+	t.Run("PostToSubmissions", testPostToManySubmissions)
+	t.Run("PostToUploadRefs", testPostToManyUploadRefs)
+	t.Run("PublicationToPosts", testPublicationToManyPosts)
+	t.Run("PublicationToPublicationMembers", testPublicationToManyPublicationMembers)
+	t.Run("PublicationToSubmissions", testPublicationToManySubmissions)
+	t.Run("ReadingListToListReadingListPosts", testReadingListToManyListReadingListPosts)
+	t.Run("SeriesToSeriesPosts", testSeriesToManySeriesPosts)
+	t.Run("ShareLinkCommentToCommentCommentMentions", testShareLinkCommentToManyCommentCommentMentions)
+	t.Run("ShareLinkCommentToParentShareLinkComments", testShareLinkCommentToManyParentShareLinkComments)
+	t.Run("ShareLinkToLinkShareLinkAccesses", testShareLinkToManyLinkShareLinkAccesses)
+	t.Run("ShareLinkToLinkShareLinkComments", testShareLinkToManyLinkShareLinkComments)
+	t.Run("TagToPostTags", testTagToManyPostTags)
+	t.Run("TagToTagAliases", testTagToManyTagAliases)
+	t.Run("TagToTagFollows", testTagToManyTagFollows)
+	t.Run("UploadToUploadVariants", testUploadToManyUploadVariants)
+	t.Run("UserToCommentMentions", testUserToManyCommentMentions)
+	t.Run("UserToHighlights", testUserToManyHighlights)
+	t.Run("UserToMeteredReads", testUserToManyMeteredReads)
+	t.Run("UserToActorNotificationActors", testUserToManyActorNotificationActors)
+	t.Run("UserToNotificationPreferences", testUserToManyNotificationPreferences)
+	t.Run("UserToNotifications", testUserToManyNotifications)
+	t.Run("UserToActorNotifications", testUserToManyActorNotifications)
+	t.Run("UserToPostAuthors", testUserToManyPostAuthors)
+	t.Run("UserToPostLikes", testUserToManyPostLikes)
+	t.Run("UserToPostMentions", testUserToManyPostMentions)
+	t.Run("UserToPublicationMembers", testUserToManyPublicationMembers)
+	t.Run("UserToReadingLists", testUserToManyReadingLists)
+	t.Run("UserToShareLinkComments", testUserToManyShareLinkComments)
+	t.Run("UserToSubmittedBySubmissions", testUserToManySubmittedBySubmissions)
+	t.Run("UserToReviewedBySubmissions", testUserToManyReviewedBySubmissions)
+	t.Run("UserToTagFollows", testUserToManyTagFollows)
+	t.Run("UserToUploads", testUserToManyUploads)
+	t.Run("UserToBlockerUserBlocks", testUserToManyBlockerUserBlocks)
+	t.Run("UserToBlockedUserBlocks", testUserToManyBlockedUserBlocks)
+	t.Run("UserToFollowerUserFollows", testUserToManyFollowerUserFollows)
+	t.Run("UserToFolloweeUserFollows", testUserToManyFolloweeUserFollows)
+	t.Run("UserToUserUploadRefs", testUserToManyUserUploadRefs)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("CommentMentionToShareLinkCommentUsingCommentCommentMentions", testCommentMentionToOneSetOpShareLinkCommentUsingComment)
+	t.Run("CommentMentionToUserUsingCommentMentions", testCommentMentionToOneSetOpUserUsingUser)
+	t.Run("HighlightToPostUsingHighlights", testHighlightToOneSetOpPostUsingPost)
+	t.Run("HighlightToUserUsingHighlights", testHighlightToOneSetOpUserUsingUser)
+	t.Run("MeteredReadToUserUsingMeteredReads", testMeteredReadToOneSetOpUserUsingUser)
+	t.Run("MeteredReadToPostUsingMeteredReads", testMeteredReadToOneSetOpPostUsingPost)
+	t.Run("NotificationActorToNotificationUsingNotificationActors", testNotificationActorToOneSetOpNotificationUsingNotification)
+	t.Run("NotificationActorToUserUsingActorNotificationActors", testNotificationActorToOneSetOpUserUsingActor)
+	t.Run("NotificationPreferenceToUserUsingNotificationPreferences", testNotificationPreferenceToOneSetOpUserUsingUser)
+	t.Run("NotificationToUserUsingNotifications", testNotificationToOneSetOpUserUsingUser)
+	t.Run("NotificationToPostUsingNotifications", testNotificationToOneSetOpPostUsingPost)
+	t.Run("NotificationToUserUsingActorNotifications", testNotificationToOneSetOpUserUsingActor)
+	t.Run("PostAuthorToPostUsingPostAuthors", testPostAuthorToOneSetOpPostUsingPost)
+	t.Run("PostAuthorToUserUsingPostAuthors", testPostAuthorToOneSetOpUserUsingUser)
+	t.Run("PostLikeToUserUsingPostLikes", testPostLikeToOneSetOpUserUsingUser)
+	t.Run("PostLikeToPostUsingPostLikes", testPostLikeToOneSetOpPostUsingPost)
+	t.Run("PostMentionToPostUsingPostMentions", testPostMentionToOneSetOpPostUsingPost)
+	t.Run("PostMentionToUserUsingPostMentions", testPostMentionToOneSetOpUserUsingUser)
+	t.Run("PostRankingToPostUsingPostRankings", testPostRankingToOneSetOpPostUsingPost)
+	t.Run("PostRevisionToPostUsingPostRevisions", testPostRevisionToOneSetOpPostUsingPost)
+	t.Run("PostTagToPostUsingPostTags", testPostTagToOneSetOpPostUsingPost)
+	t.Run("PostTagToTagUsingPostTags", testPostTagToOneSetOpTagUsingTag)
+	t.Run("PostViewToPostUsingPostViews", testPostViewToOneSetOpPostUsingPost)
+	t.Run("PostToPublicationUsingPosts", testPostToOneSetOpPublicationUsingPublication)
+	t.Run("PublicationMemberToPublicationUsingPublicationMembers", testPublicationMemberToOneSetOpPublicationUsingPublication)
+	t.Run("PublicationMemberToUserUsingPublicationMembers", testPublicationMemberToOneSetOpUserUsingUser)
+	t.Run("ReadingListPostToReadingListUsingListReadingListPosts", testReadingListPostToOneSetOpReadingListUsingList)
+	t.Run("ReadingListPostToPostUsingReadingListPosts", testReadingListPostToOneSetOpPostUsingPost)
+	t.Run("ReadingListToUserUsingReadingLists", testReadingListToOneSetOpUserUsingUser)
+	t.Run("RelatedPostToPostUsingRelatedPosts", testRelatedPostToOneSetOpPostUsingPost)
+	t.Run("SeriesPostToSeriesUsingSeriesPosts", testSeriesPostToOneSetOpSeriesUsingSeries)
+	t.Run("SeriesPostToPostUsingSeriesPost", testSeriesPostToOneSetOpPostUsingPost)
+	t.Run("ShareLinkAccessToShareLinkUsingLinkShareLinkAccesses", testShareLinkAccessToOneSetOpShareLinkUsingLink)
+	t.Run("ShareLinkCommentToShareLinkUsingLinkShareLinkComments", testShareLinkCommentToOneSetOpShareLinkUsingLink)
+	t.Run("ShareLinkCommentToShareLinkCommentUsingParentShareLinkComments", testShareLinkCommentToOneSetOpShareLinkCommentUsingParent)
+	t.Run("ShareLinkCommentToUserUsingShareLinkComments", testShareLinkCommentToOneSetOpUserUsingUser)
+	t.Run("ShareLinkToPostUsingShareLinks", testShareLinkToOneSetOpPostUsingPost)
+	t.Run("SubmissionToPublicationUsingSubmissions", testSubmissionToOneSetOpPublicationUsingPublication)
+	t.Run("SubmissionToPostUsingSubmissions", testSubmissionToOneSetOpPostUsingPost)
+	t.Run("SubmissionToUserUsingSubmittedBySubmissions", testSubmissionToOneSetOpUserUsingSubmittedByUser)
+	t.Run("SubmissionToUserUsingReviewedBySubmissions", testSubmissionToOneSetOpUserUsingReviewedByUser)
+	t.Run("SubscriptionToUserUsingSubscription", testSubscriptionToOneSetOpUserUsingUser)
+	t.Run("TagAliasToTagUsingTagAliases", testTagAliasToOneSetOpTagUsingTag)
+	t.Run("TagFollowToUserUsingTagFollows", testTagFollowToOneSetOpUserUsingUser)
+	t.Run("TagFollowToTagUsingTagFollows", testTagFollowToOneSetOpTagUsingTag)
+	t.Run("UploadRefToPostUsingUploadRefs", testUploadRefToOneSetOpPostUsingPost)
+	t.Run("UploadVariantToUploadUsingUploadVariants", testUploadVariantToOneSetOpUploadUsingUpload)
+	t.Run("UploadToUserUsingUploads", testUploadToOneSetOpUserUsingUser)
+	t.Run("UserBlockToUserUsingBlockerUserBlocks", testUserBlockToOneSetOpUserUsingBlocker)
+	t.Run("UserBlockToUserUsingBlockedUserBlocks", testUserBlockToOneSetOpUserUsingBlocked)
+	t.Run("UserFollowToUserUsingFollowerUserFollows", testUserFollowToOneSetOpUserUsingFollower)
+	t.Run("UserFollowToUserUsingFolloweeUserFollows", testUserFollowToOneSetOpUserUsingFollowee)
+	t.Run("UserUploadRefToUserUsingUserUploadRefs", testUserUploadRefToOneSetOpUserUsingUser)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("NotificationToPostUsingNotifications", testNotificationToOneRemoveOpPostUsingPost)
+	t.Run("NotificationToUserUsingActorNotifications", testNotificationToOneRemoveOpUserUsingActor)
+	t.Run("PostToPublicationUsingPosts", testPostToOneRemoveOpPublicationUsingPublication)
+	t.Run("ShareLinkCommentToShareLinkCommentUsingParentShareLinkComments", testShareLinkCommentToOneRemoveOpShareLinkCommentUsingParent)
+	t.Run("ShareLinkCommentToUserUsingShareLinkComments", testShareLinkCommentToOneRemoveOpUserUsingUser)
+	t.Run("SubmissionToUserUsingReviewedBySubmissions", testSubmissionToOneRemoveOpUserUsingReviewedByUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("PostToSeriesPostUsingSeriesPost", testPostOneToOneSetOpSeriesPostUsingSeriesPost)
+	t.Run("UserToSubscriptionUsingSubscription", testUserOneToOneSetOpSubscriptionUsingSubscription)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -116,42 +743,278 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("NotificationToNotificationActors", testNotificationToManyAddOpNotificationActors)
+	t.Run("PostToHighlights", testPostToManyAddOpHighlights)
+	t.Run("PostToMeteredReads", testPostToManyAddOpMeteredReads)
+	t.Run("PostToNotifications", testPostToManyAddOpNotifications)
+	t.Run("PostToPostAuthors", testPostToManyAddOpPostAuthors)
+	t.Run("PostToPostLikes", testPostToManyAddOpPostLikes)
+	t.Run("PostToPostMentions", testPostToManyAddOpPostMentions)
+	t.Run("PostToPostRankings", testPostToManyAddOpPostRankings)
+	t.Run("PostToPostRevisions", testPostToManyAddOpPostRevisions)
+	t.Run("PostToPostTags", testPostToManyAddOpPostTags)
+	t.Run("PostToPostViews", testPostToManyAddOpPostViews)
+	t.Run("PostToReadingListPosts", testPostToManyAddOpReadingListPosts)
+	t.Run("PostToRelatedPosts", testPostToManyAddOpRelatedPosts)
+	t.Run("PostToShareLinks", testPostToManyAddOpShareLinks)
+	t.Run("PostToSubmissions", testPostToManyAddOpSubmissions)
+	t.Run("PostToUploadRefs", testPostToManyAddOpUploadRefs)
+	t.Run("PublicationToPosts", testPublicationToManyAddOpPosts)
+	t.Run("PublicationToPublicationMembers", testPublicationToManyAddOpPublicationMembers)
+	t.Run("PublicationToSubmissions", testPublicationToManyAddOpSubmissions)
+	t.Run("ReadingListToListReadingListPosts", testReadingListToManyAddOpListReadingListPosts)
+	t.Run("SeriesToSeriesPosts", testSeriesToManyAddOpSeriesPosts)
+	t.Run("ShareLinkCommentToCommentCommentMentions", testShareLinkCommentToManyAddOpCommentCommentMentions)
+	t.Run("ShareLinkCommentToParentShareLinkComments", testShareLinkCommentToManyAddOpParentShareLinkComments)
+	t.Run("ShareLinkToLinkShareLinkAccesses", testShareLinkToManyAddOpLinkShareLinkAccesses)
+	t.Run("ShareLinkToLinkShareLinkComments", testShareLinkToManyAddOpLinkShareLinkComments)
+	t.Run("TagToPostTags", testTagToManyAddOpPostTags)
+	t.Run("TagToTagAliases", testTagToManyAddOpTagAliases)
+	t.Run("TagToTagFollows", testTagToManyAddOpTagFollows)
+	t.Run("UploadToUploadVariants", testUploadToManyAddOpUploadVariants)
+	t.Run("UserToCommentMentions", testUserToManyAddOpCommentMentions)
+	t.Run("UserToHighlights", testUserToManyAddOpHighlights)
+	t.Run("UserToMeteredReads", testUserToManyAddOpMeteredReads)
+	t.Run("UserToActorNotificationActors", testUserToManyAddOpActorNotificationActors)
+	t.Run("UserToNotificationPreferences", testUserToManyAddOpNotificationPreferences)
+	t.Run("UserToNotifications", testUserToManyAddOpNotifications)
+	t.Run("UserToActorNotifications", testUserToManyAddOpActorNotifications)
+	t.Run("UserToPostAuthors", testUserToManyAddOpPostAuthors)
+	t.Run("UserToPostLikes", testUserToManyAddOpPostLikes)
+	t.Run("UserToPostMentions", testUserToManyAddOpPostMentions)
+	t.Run("UserToPublicationMembers", testUserToManyAddOpPublicationMembers)
+	t.Run("UserToReadingLists", testUserToManyAddOpReadingLists)
+	t.Run("UserToShareLinkComments", testUserToManyAddOpShareLinkComments)
+	t.Run("UserToSubmittedBySubmissions", testUserToManyAddOpSubmittedBySubmissions)
+	t.Run("UserToReviewedBySubmissions", testUserToManyAddOpReviewedBySubmissions)
+	t.Run("UserToTagFollows", testUserToManyAddOpTagFollows)
+	t.Run("UserToUploads", testUserToManyAddOpUploads)
+	t.Run("UserToBlockerUserBlocks", testUserToManyAddOpBlockerUserBlocks)
+	t.Run("UserToBlockedUserBlocks", testUserToManyAddOpBlockedUserBlocks)
+	t.Run("UserToFollowerUserFollows", testUserToManyAddOpFollowerUserFollows)
+	t.Run("UserToFolloweeUserFollows", testUserToManyAddOpFolloweeUserFollows)
+	t.Run("UserToUserUploadRefs", testUserToManyAddOpUserUploadRefs)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("PostToNotifications", testPostToManySetOpNotifications)
+	t.Run("PublicationToPosts", testPublicationToManySetOpPosts)
+	t.Run("ShareLinkCommentToParentShareLinkComments", testShareLinkCommentToManySetOpParentShareLinkComments)
+	t.Run("UserToActorNotifications", testUserToManySetOpActorNotifications)
+	t.Run("UserToShareLinkComments", testUserToManySetOpShareLinkComments)
+	t.Run("UserToReviewedBySubmissions", testUserToManySetOpReviewedBySubmissions)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("PostToNotifications", testPostToManyRemoveOpNotifications)
+	t.Run("PublicationToPosts", testPublicationToManyRemoveOpPosts)
+	t.Run("ShareLinkCommentToParentShareLinkComments", testShareLinkCommentToManyRemoveOpParentShareLinkComments)
+	t.Run("UserToActorNotifications", testUserToManyRemoveOpActorNotifications)
+	t.Run("UserToShareLinkComments", testUserToManyRemoveOpShareLinkComments)
+	t.Run("UserToReviewedBySubmissions", testUserToManyRemoveOpReviewedBySubmissions)
+}
 
 func TestReload(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsReload)
 	t.Run("GorpMigrations", testGorpMigrationsReload)
+	t.Run("Highlights", testHighlightsReload)
+	t.Run("MeteredReads", testMeteredReadsReload)
+	t.Run("NotificationActors", testNotificationActorsReload)
+	t.Run("NotificationPreferences", testNotificationPreferencesReload)
+	t.Run("Notifications", testNotificationsReload)
+	t.Run("PostAuthors", testPostAuthorsReload)
+	t.Run("PostLikes", testPostLikesReload)
+	t.Run("PostMentions", testPostMentionsReload)
+	t.Run("PostRankings", testPostRankingsReload)
+	t.Run("PostRevisions", testPostRevisionsReload)
+	t.Run("PostTags", testPostTagsReload)
+	t.Run("PostViews", testPostViewsReload)
 	t.Run("Posts", testPostsReload)
+	t.Run("PublicationMembers", testPublicationMembersReload)
+	t.Run("Publications", testPublicationsReload)
+	t.Run("ReadingListPosts", testReadingListPostsReload)
+	t.Run("ReadingLists", testReadingListsReload)
+	t.Run("RelatedPosts", testRelatedPostsReload)
+	t.Run("AllSeries", testAllSeriesReload)
+	t.Run("SeriesPosts", testSeriesPostsReload)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesReload)
+	t.Run("ShareLinkComments", testShareLinkCommentsReload)
+	t.Run("ShareLinks", testShareLinksReload)
+	t.Run("Submissions", testSubmissionsReload)
+	t.Run("Subscriptions", testSubscriptionsReload)
+	t.Run("TagAliases", testTagAliasesReload)
+	t.Run("TagFollows", testTagFollowsReload)
+	t.Run("Tags", testTagsReload)
+	t.Run("UploadRefs", testUploadRefsReload)
+	t.Run("UploadVariants", testUploadVariantsReload)
+	t.Run("Uploads", testUploadsReload)
+	t.Run("UserBlocks", testUserBlocksReload)
+	t.Run("UserFollows", testUserFollowsReload)
+	t.Run("UserUploadRefs", testUserUploadRefsReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsReloadAll)
 	t.Run("GorpMigrations", testGorpMigrationsReloadAll)
+	t.Run("Highlights", testHighlightsReloadAll)
+	t.Run("MeteredReads", testMeteredReadsReloadAll)
+	t.Run("NotificationActors", testNotificationActorsReloadAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesReloadAll)
+	t.Run("Notifications", testNotificationsReloadAll)
+	t.Run("PostAuthors", testPostAuthorsReloadAll)
+	t.Run("PostLikes", testPostLikesReloadAll)
+	t.Run("PostMentions", testPostMentionsReloadAll)
+	t.Run("PostRankings", testPostRankingsReloadAll)
+	t.Run("PostRevisions", testPostRevisionsReloadAll)
+	t.Run("PostTags", testPostTagsReloadAll)
+	t.Run("PostViews", testPostViewsReloadAll)
 	t.Run("Posts", testPostsReloadAll)
+	t.Run("PublicationMembers", testPublicationMembersReloadAll)
+	t.Run("Publications", testPublicationsReloadAll)
+	t.Run("ReadingListPosts", testReadingListPostsReloadAll)
+	t.Run("ReadingLists", testReadingListsReloadAll)
+	t.Run("RelatedPosts", testRelatedPostsReloadAll)
+	t.Run("AllSeries", testAllSeriesReloadAll)
+	t.Run("SeriesPosts", testSeriesPostsReloadAll)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesReloadAll)
+	t.Run("ShareLinkComments", testShareLinkCommentsReloadAll)
+	t.Run("ShareLinks", testShareLinksReloadAll)
+	t.Run("Submissions", testSubmissionsReloadAll)
+	t.Run("Subscriptions", testSubscriptionsReloadAll)
+	t.Run("TagAliases", testTagAliasesReloadAll)
+	t.Run("TagFollows", testTagFollowsReloadAll)
+	t.Run("Tags", testTagsReloadAll)
+	t.Run("UploadRefs", testUploadRefsReloadAll)
+	t.Run("UploadVariants", testUploadVariantsReloadAll)
+	t.Run("Uploads", testUploadsReloadAll)
+	t.Run("UserBlocks", testUserBlocksReloadAll)
+	t.Run("UserFollows", testUserFollowsReloadAll)
+	t.Run("UserUploadRefs", testUserUploadRefsReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsSelect)
 	t.Run("GorpMigrations", testGorpMigrationsSelect)
+	t.Run("Highlights", testHighlightsSelect)
+	t.Run("MeteredReads", testMeteredReadsSelect)
+	t.Run("NotificationActors", testNotificationActorsSelect)
+	t.Run("NotificationPreferences", testNotificationPreferencesSelect)
+	t.Run("Notifications", testNotificationsSelect)
+	t.Run("PostAuthors", testPostAuthorsSelect)
+	t.Run("PostLikes", testPostLikesSelect)
+	t.Run("PostMentions", testPostMentionsSelect)
+	t.Run("PostRankings", testPostRankingsSelect)
+	t.Run("PostRevisions", testPostRevisionsSelect)
+	t.Run("PostTags", testPostTagsSelect)
+	t.Run("PostViews", testPostViewsSelect)
 	t.Run("Posts", testPostsSelect)
+	t.Run("PublicationMembers", testPublicationMembersSelect)
+	t.Run("Publications", testPublicationsSelect)
+	t.Run("ReadingListPosts", testReadingListPostsSelect)
+	t.Run("ReadingLists", testReadingListsSelect)
+	t.Run("RelatedPosts", testRelatedPostsSelect)
+	t.Run("AllSeries", testAllSeriesSelect)
+	t.Run("SeriesPosts", testSeriesPostsSelect)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesSelect)
+	t.Run("ShareLinkComments", testShareLinkCommentsSelect)
+	t.Run("ShareLinks", testShareLinksSelect)
+	t.Run("Submissions", testSubmissionsSelect)
+	t.Run("Subscriptions", testSubscriptionsSelect)
+	t.Run("TagAliases", testTagAliasesSelect)
+	t.Run("TagFollows", testTagFollowsSelect)
+	t.Run("Tags", testTagsSelect)
+	t.Run("UploadRefs", testUploadRefsSelect)
+	t.Run("UploadVariants", testUploadVariantsSelect)
+	t.Run("Uploads", testUploadsSelect)
+	t.Run("UserBlocks", testUserBlocksSelect)
+	t.Run("UserFollows", testUserFollowsSelect)
+	t.Run("UserUploadRefs", testUserUploadRefsSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsUpdate)
 	t.Run("GorpMigrations", testGorpMigrationsUpdate)
+	t.Run("Highlights", testHighlightsUpdate)
+	t.Run("MeteredReads", testMeteredReadsUpdate)
+	t.Run("NotificationActors", testNotificationActorsUpdate)
+	t.Run("NotificationPreferences", testNotificationPreferencesUpdate)
+	t.Run("Notifications", testNotificationsUpdate)
+	t.Run("PostAuthors", testPostAuthorsUpdate)
+	t.Run("PostLikes", testPostLikesUpdate)
+	t.Run("PostMentions", testPostMentionsUpdate)
+	t.Run("PostRankings", testPostRankingsUpdate)
+	t.Run("PostRevisions", testPostRevisionsUpdate)
+	t.Run("PostTags", testPostTagsUpdate)
+	t.Run("PostViews", testPostViewsUpdate)
 	t.Run("Posts", testPostsUpdate)
+	t.Run("PublicationMembers", testPublicationMembersUpdate)
+	t.Run("Publications", testPublicationsUpdate)
+	t.Run("ReadingListPosts", testReadingListPostsUpdate)
+	t.Run("ReadingLists", testReadingListsUpdate)
+	t.Run("RelatedPosts", testRelatedPostsUpdate)
+	t.Run("AllSeries", testAllSeriesUpdate)
+	t.Run("SeriesPosts", testSeriesPostsUpdate)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesUpdate)
+	t.Run("ShareLinkComments", testShareLinkCommentsUpdate)
+	t.Run("ShareLinks", testShareLinksUpdate)
+	t.Run("Submissions", testSubmissionsUpdate)
+	t.Run("Subscriptions", testSubscriptionsUpdate)
+	t.Run("TagAliases", testTagAliasesUpdate)
+	t.Run("TagFollows", testTagFollowsUpdate)
+	t.Run("Tags", testTagsUpdate)
+	t.Run("UploadRefs", testUploadRefsUpdate)
+	t.Run("UploadVariants", testUploadVariantsUpdate)
+	t.Run("Uploads", testUploadsUpdate)
+	t.Run("UserBlocks", testUserBlocksUpdate)
+	t.Run("UserFollows", testUserFollowsUpdate)
+	t.Run("UserUploadRefs", testUserUploadRefsUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsSliceUpdateAll)
 	t.Run("GorpMigrations", testGorpMigrationsSliceUpdateAll)
+	t.Run("Highlights", testHighlightsSliceUpdateAll)
+	t.Run("MeteredReads", testMeteredReadsSliceUpdateAll)
+	t.Run("NotificationActors", testNotificationActorsSliceUpdateAll)
+	t.Run("NotificationPreferences", testNotificationPreferencesSliceUpdateAll)
+	t.Run("Notifications", testNotificationsSliceUpdateAll)
+	t.Run("PostAuthors", testPostAuthorsSliceUpdateAll)
+	t.Run("PostLikes", testPostLikesSliceUpdateAll)
+	t.Run("PostMentions", testPostMentionsSliceUpdateAll)
+	t.Run("PostRankings", testPostRankingsSliceUpdateAll)
+	t.Run("PostRevisions", testPostRevisionsSliceUpdateAll)
+	t.Run("PostTags", testPostTagsSliceUpdateAll)
+	t.Run("PostViews", testPostViewsSliceUpdateAll)
 	t.Run("Posts", testPostsSliceUpdateAll)
+	t.Run("PublicationMembers", testPublicationMembersSliceUpdateAll)
+	t.Run("Publications", testPublicationsSliceUpdateAll)
+	t.Run("ReadingListPosts", testReadingListPostsSliceUpdateAll)
+	t.Run("ReadingLists", testReadingListsSliceUpdateAll)
+	t.Run("RelatedPosts", testRelatedPostsSliceUpdateAll)
+	t.Run("AllSeries", testAllSeriesSliceUpdateAll)
+	t.Run("SeriesPosts", testSeriesPostsSliceUpdateAll)
+	t.Run("ShareLinkAccesses", testShareLinkAccessesSliceUpdateAll)
+	t.Run("ShareLinkComments", testShareLinkCommentsSliceUpdateAll)
+	t.Run("ShareLinks", testShareLinksSliceUpdateAll)
+	t.Run("Submissions", testSubmissionsSliceUpdateAll)
+	t.Run("Subscriptions", testSubscriptionsSliceUpdateAll)
+	t.Run("TagAliases", testTagAliasesSliceUpdateAll)
+	t.Run("TagFollows", testTagFollowsSliceUpdateAll)
+	t.Run("Tags", testTagsSliceUpdateAll)
+	t.Run("UploadRefs", testUploadRefsSliceUpdateAll)
+	t.Run("UploadVariants", testUploadVariantsSliceUpdateAll)
+	t.Run("Uploads", testUploadsSliceUpdateAll)
+	t.Run("UserBlocks", testUserBlocksSliceUpdateAll)
+	t.Run("UserFollows", testUserFollowsSliceUpdateAll)
+	t.Run("UserUploadRefs", testUserUploadRefsSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
@@ -4,11 +4,79 @@
 package models
 
 var TableNames = struct {
-	GorpMigrations string
-	Posts          string
-	Users          string
+	CommentMentions         string
+	GorpMigrations          string
+	Highlights              string
+	MeteredReads            string
+	NotificationActors      string
+	NotificationPreferences string
+	Notifications           string
+	PostAuthors             string
+	PostLikes               string
+	PostMentions            string
+	PostRankings            string
+	PostRevisions           string
+	PostTags                string
+	PostViews               string
+	Posts                   string
+	PublicationMembers      string
+	Publications            string
+	ReadingListPosts        string
+	ReadingLists            string
+	RelatedPosts            string
+	Series                  string
+	SeriesPosts             string
+	ShareLinkAccesses       string
+	ShareLinkComments       string
+	ShareLinks              string
+	Submissions             string
+	Subscriptions           string
+	TagAliases              string
+	TagFollows              string
+	Tags                    string
+	UploadRefs              string
+	UploadVariants          string
+	Uploads                 string
+	UserBlocks              string
+	UserFollows             string
+	UserUploadRefs          string
+	Users                   string
 }{
-	GorpMigrations: "gorp_migrations",
-	Posts:          "posts",
-	Users:          "users",
+	CommentMentions:         "comment_mentions",
+	GorpMigrations:          "gorp_migrations",
+	Highlights:              "highlights",
+	MeteredReads:            "metered_reads",
+	NotificationActors:      "notification_actors",
+	NotificationPreferences: "notification_preferences",
+	Notifications:           "notifications",
+	PostAuthors:             "post_authors",
+	PostLikes:               "post_likes",
+	PostMentions:            "post_mentions",
+	PostRankings:            "post_rankings",
+	PostRevisions:           "post_revisions",
+	PostTags:                "post_tags",
+	PostViews:               "post_views",
+	Posts:                   "posts",
+	PublicationMembers:      "publication_members",
+	Publications:            "publications",
+	ReadingListPosts:        "reading_list_posts",
+	ReadingLists:            "reading_lists",
+	RelatedPosts:            "related_posts",
+	Series:                  "series",
+	SeriesPosts:             "series_posts",
+	ShareLinkAccesses:       "share_link_accesses",
+	ShareLinkComments:       "share_link_comments",
+	ShareLinks:              "share_links",
+	Submissions:             "submissions",
+	Subscriptions:           "subscriptions",
+	TagAliases:              "tag_aliases",
+	TagFollows:              "tag_follows",
+	Tags:                    "tags",
+	UploadRefs:              "upload_refs",
+	UploadVariants:          "upload_variants",
+	Uploads:                 "uploads",
+	UserBlocks:              "user_blocks",
+	UserFollows:             "user_follows",
+	UserUploadRefs:          "user_upload_refs",
+	Users:                   "users",
 }
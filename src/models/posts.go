@@ -25,187 +25,202 @@ import (
 
 // Post is an object representing the database table.
 type Post struct {
-	ID        int               `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Author    null.String       `boil:"author" json:"author,omitempty" toml:"author" yaml:"author,omitempty"`
-	Document  null.String       `boil:"document" json:"document,omitempty" toml:"document" yaml:"document,omitempty"`
-	Comments  null.String       `boil:"comments" json:"comments,omitempty" toml:"comments" yaml:"comments,omitempty"`
-	Likes     null.Int          `boil:"likes" json:"likes,omitempty" toml:"likes" yaml:"likes,omitempty"`
-	Tags      types.StringArray `boil:"tags" json:"tags,omitempty" toml:"tags" yaml:"tags,omitempty"`
-	CreatedAt time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID              int               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Author          null.String       `boil:"author" json:"author,omitempty" toml:"author" yaml:"author,omitempty"`
+	Document        null.String       `boil:"document" json:"document,omitempty" toml:"document" yaml:"document,omitempty"`
+	Comments        null.String       `boil:"comments" json:"comments,omitempty" toml:"comments" yaml:"comments,omitempty"`
+	Likes           null.Int          `boil:"likes" json:"likes,omitempty" toml:"likes" yaml:"likes,omitempty"`
+	Tags            types.StringArray `boil:"tags" json:"tags,omitempty" toml:"tags" yaml:"tags,omitempty"`
+	CreatedAt       time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt       null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	PublishAt       null.Time         `boil:"publish_at" json:"publish_at,omitempty" toml:"publish_at" yaml:"publish_at,omitempty"`
+	PublishedAt     null.Time         `boil:"published_at" json:"published_at,omitempty" toml:"published_at" yaml:"published_at,omitempty"`
+	Title           null.String       `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	DocumentHTML    null.String       `boil:"document_html" json:"document_html,omitempty" toml:"document_html" yaml:"document_html,omitempty"`
+	TableOfContents null.JSON         `boil:"table_of_contents" json:"table_of_contents,omitempty" toml:"table_of_contents" yaml:"table_of_contents,omitempty"`
+	Blocks          null.JSON         `boil:"blocks" json:"blocks,omitempty" toml:"blocks" yaml:"blocks,omitempty"`
+	WordCount       null.Int          `boil:"word_count" json:"word_count,omitempty" toml:"word_count" yaml:"word_count,omitempty"`
+	ReadingTime     null.Int          `boil:"reading_time" json:"reading_time,omitempty" toml:"reading_time" yaml:"reading_time,omitempty"`
+	Excerpt         null.String       `boil:"excerpt" json:"excerpt,omitempty" toml:"excerpt" yaml:"excerpt,omitempty"`
+	PublicationID   null.Int          `boil:"publication_id" json:"publication_id,omitempty" toml:"publication_id" yaml:"publication_id,omitempty"`
+	MembersOnly     bool              `boil:"members_only" json:"members_only" toml:"members_only" yaml:"members_only"`
 
 	R *postR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L postL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PostColumns = struct {
-	ID        string
-	Author    string
-	Document  string
-	Comments  string
-	Likes     string
-	Tags      string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID              string
+	Author          string
+	Document        string
+	Comments        string
+	Likes           string
+	Tags            string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	PublishAt       string
+	PublishedAt     string
+	Title           string
+	DocumentHTML    string
+	TableOfContents string
+	Blocks          string
+	WordCount       string
+	ReadingTime     string
+	Excerpt         string
+	PublicationID   string
+	MembersOnly     string
 }{
-	ID:        "id",
-	Author:    "author",
-	Document:  "document",
-	Comments:  "comments",
-	Likes:     "likes",
-	Tags:      "tags",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	DeletedAt: "deleted_at",
+	ID:              "id",
+	Author:          "author",
+	Document:        "document",
+	Comments:        "comments",
+	Likes:           "likes",
+	Tags:            "tags",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	PublishAt:       "publish_at",
+	PublishedAt:     "published_at",
+	Title:           "title",
+	DocumentHTML:    "document_html",
+	TableOfContents: "table_of_contents",
+	Blocks:          "blocks",
+	WordCount:       "word_count",
+	ReadingTime:     "reading_time",
+	Excerpt:         "excerpt",
+	PublicationID:   "publication_id",
+	MembersOnly:     "members_only",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
+type whereHelpernull_JSON struct{ field string }
 
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpertypes_StringArray struct{ field string }
-
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
-	return qmhelper.WhereIsNotNull(w.field)
-}
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
 var PostWhere = struct {
-	ID        whereHelperint
-	Author    whereHelpernull_String
-	Document  whereHelpernull_String
-	Comments  whereHelpernull_String
-	Likes     whereHelpernull_Int
-	Tags      whereHelpertypes_StringArray
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	DeletedAt whereHelpernull_Time
+	ID              whereHelperint
+	Author          whereHelpernull_String
+	Document        whereHelpernull_String
+	Comments        whereHelpernull_String
+	Likes           whereHelpernull_Int
+	Tags            whereHelpertypes_StringArray
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	DeletedAt       whereHelpernull_Time
+	PublishAt       whereHelpernull_Time
+	PublishedAt     whereHelpernull_Time
+	Title           whereHelpernull_String
+	DocumentHTML    whereHelpernull_String
+	TableOfContents whereHelpernull_JSON
+	Blocks          whereHelpernull_JSON
+	WordCount       whereHelpernull_Int
+	ReadingTime     whereHelpernull_Int
+	Excerpt         whereHelpernull_String
+	PublicationID   whereHelpernull_Int
+	MembersOnly     whereHelperbool
 }{
-	ID:        whereHelperint{field: "\"posts\".\"id\""},
-	Author:    whereHelpernull_String{field: "\"posts\".\"author\""},
-	Document:  whereHelpernull_String{field: "\"posts\".\"document\""},
-	Comments:  whereHelpernull_String{field: "\"posts\".\"comments\""},
-	Likes:     whereHelpernull_Int{field: "\"posts\".\"likes\""},
-	Tags:      whereHelpertypes_StringArray{field: "\"posts\".\"tags\""},
-	CreatedAt: whereHelpertime_Time{field: "\"posts\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"posts\".\"updated_at\""},
-	DeletedAt: whereHelpernull_Time{field: "\"posts\".\"deleted_at\""},
+	ID:              whereHelperint{field: "\"posts\".\"id\""},
+	Author:          whereHelpernull_String{field: "\"posts\".\"author\""},
+	Document:        whereHelpernull_String{field: "\"posts\".\"document\""},
+	Comments:        whereHelpernull_String{field: "\"posts\".\"comments\""},
+	Likes:           whereHelpernull_Int{field: "\"posts\".\"likes\""},
+	Tags:            whereHelpertypes_StringArray{field: "\"posts\".\"tags\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"posts\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"posts\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"posts\".\"deleted_at\""},
+	PublishAt:       whereHelpernull_Time{field: "\"posts\".\"publish_at\""},
+	PublishedAt:     whereHelpernull_Time{field: "\"posts\".\"published_at\""},
+	Title:           whereHelpernull_String{field: "\"posts\".\"title\""},
+	DocumentHTML:    whereHelpernull_String{field: "\"posts\".\"document_html\""},
+	TableOfContents: whereHelpernull_JSON{field: "\"posts\".\"table_of_contents\""},
+	Blocks:          whereHelpernull_JSON{field: "\"posts\".\"blocks\""},
+	WordCount:       whereHelpernull_Int{field: "\"posts\".\"word_count\""},
+	ReadingTime:     whereHelpernull_Int{field: "\"posts\".\"reading_time\""},
+	Excerpt:         whereHelpernull_String{field: "\"posts\".\"excerpt\""},
+	PublicationID:   whereHelpernull_Int{field: "\"posts\".\"publication_id\""},
+	MembersOnly:     whereHelperbool{field: "\"posts\".\"members_only\""},
 }
 
 // PostRels is where relationship names are stored.
 var PostRels = struct {
-}{}
+	Publication      string
+	SeriesPost       string
+	Highlights       string
+	MeteredReads     string
+	Notifications    string
+	PostAuthors      string
+	PostLikes        string
+	PostMentions     string
+	PostRankings     string
+	PostRevisions    string
+	PostTags         string
+	PostViews        string
+	ReadingListPosts string
+	RelatedPosts     string
+	ShareLinks       string
+	Submissions      string
+	UploadRefs       string
+}{
+	Publication:      "Publication",
+	SeriesPost:       "SeriesPost",
+	Highlights:       "Highlights",
+	MeteredReads:     "MeteredReads",
+	Notifications:    "Notifications",
+	PostAuthors:      "PostAuthors",
+	PostLikes:        "PostLikes",
+	PostMentions:     "PostMentions",
+	PostRankings:     "PostRankings",
+	PostRevisions:    "PostRevisions",
+	PostTags:         "PostTags",
+	PostViews:        "PostViews",
+	ReadingListPosts: "ReadingListPosts",
+	RelatedPosts:     "RelatedPosts",
+	ShareLinks:       "ShareLinks",
+	Submissions:      "Submissions",
+	UploadRefs:       "UploadRefs",
+}
 
 // postR is where relationships are stored.
 type postR struct {
+	Publication      *Publication         `boil:"Publication" json:"Publication" toml:"Publication" yaml:"Publication"`
+	SeriesPost       *SeriesPost          `boil:"SeriesPost" json:"SeriesPost" toml:"SeriesPost" yaml:"SeriesPost"`
+	Highlights       HighlightSlice       `boil:"Highlights" json:"Highlights" toml:"Highlights" yaml:"Highlights"`
+	MeteredReads     MeteredReadSlice     `boil:"MeteredReads" json:"MeteredReads" toml:"MeteredReads" yaml:"MeteredReads"`
+	Notifications    NotificationSlice    `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	PostAuthors      PostAuthorSlice      `boil:"PostAuthors" json:"PostAuthors" toml:"PostAuthors" yaml:"PostAuthors"`
+	PostLikes        PostLikeSlice        `boil:"PostLikes" json:"PostLikes" toml:"PostLikes" yaml:"PostLikes"`
+	PostMentions     PostMentionSlice     `boil:"PostMentions" json:"PostMentions" toml:"PostMentions" yaml:"PostMentions"`
+	PostRankings     PostRankingSlice     `boil:"PostRankings" json:"PostRankings" toml:"PostRankings" yaml:"PostRankings"`
+	PostRevisions    PostRevisionSlice    `boil:"PostRevisions" json:"PostRevisions" toml:"PostRevisions" yaml:"PostRevisions"`
+	PostTags         PostTagSlice         `boil:"PostTags" json:"PostTags" toml:"PostTags" yaml:"PostTags"`
+	PostViews        PostViewSlice        `boil:"PostViews" json:"PostViews" toml:"PostViews" yaml:"PostViews"`
+	ReadingListPosts ReadingListPostSlice `boil:"ReadingListPosts" json:"ReadingListPosts" toml:"ReadingListPosts" yaml:"ReadingListPosts"`
+	RelatedPosts     RelatedPostSlice     `boil:"RelatedPosts" json:"RelatedPosts" toml:"RelatedPosts" yaml:"RelatedPosts"`
+	ShareLinks       ShareLinkSlice       `boil:"ShareLinks" json:"ShareLinks" toml:"ShareLinks" yaml:"ShareLinks"`
+	Submissions      SubmissionSlice      `boil:"Submissions" json:"Submissions" toml:"Submissions" yaml:"Submissions"`
+	UploadRefs       UploadRefSlice       `boil:"UploadRefs" json:"UploadRefs" toml:"UploadRefs" yaml:"UploadRefs"`
 }
 
 // NewStruct creates a new relationship struct
@@ -217,9 +232,9 @@ func (*postR) NewStruct() *postR {
 type postL struct{}
 
 var (
-	postAllColumns            = []string{"id", "author", "document", "comments", "likes", "tags", "created_at", "updated_at", "deleted_at"}
-	postColumnsWithoutDefault = []string{"author", "document", "comments", "likes", "tags", "deleted_at"}
-	postColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	postAllColumns            = []string{"id", "author", "document", "comments", "likes", "tags", "created_at", "updated_at", "deleted_at", "publish_at", "published_at", "title", "document_html", "table_of_contents", "blocks", "word_count", "reading_time", "excerpt", "publication_id", "members_only"}
+	postColumnsWithoutDefault = []string{"author", "document", "comments", "likes", "tags", "deleted_at", "publish_at", "published_at", "title", "document_html", "table_of_contents", "blocks", "word_count", "reading_time", "excerpt", "publication_id"}
+	postColumnsWithDefault    = []string{"id", "created_at", "updated_at", "members_only"}
 	postPrimaryKeyColumns     = []string{"id"}
 )
 
@@ -309,198 +324,3216 @@ func (o *Post) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecuto
 		}
 	}
 
-	return nil
-}
-
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *Post) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Post) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Post) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Post) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Post) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Post) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Post) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range postAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPostHook registers your hook function for all future operations.
+func AddPostHook(hookPoint boil.HookPoint, postHook PostHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		postBeforeInsertHooks = append(postBeforeInsertHooks, postHook)
+	case boil.BeforeUpdateHook:
+		postBeforeUpdateHooks = append(postBeforeUpdateHooks, postHook)
+	case boil.BeforeDeleteHook:
+		postBeforeDeleteHooks = append(postBeforeDeleteHooks, postHook)
+	case boil.BeforeUpsertHook:
+		postBeforeUpsertHooks = append(postBeforeUpsertHooks, postHook)
+	case boil.AfterInsertHook:
+		postAfterInsertHooks = append(postAfterInsertHooks, postHook)
+	case boil.AfterSelectHook:
+		postAfterSelectHooks = append(postAfterSelectHooks, postHook)
+	case boil.AfterUpdateHook:
+		postAfterUpdateHooks = append(postAfterUpdateHooks, postHook)
+	case boil.AfterDeleteHook:
+		postAfterDeleteHooks = append(postAfterDeleteHooks, postHook)
+	case boil.AfterUpsertHook:
+		postAfterUpsertHooks = append(postAfterUpsertHooks, postHook)
+	}
+}
+
+// One returns a single post record from the query.
+func (q postQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Post, error) {
+	o := &Post{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for posts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Post records from the query.
+func (q postQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostSlice, error) {
+	var o []*Post
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Post slice")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Post records in the query.
+func (q postQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count posts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q postQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if posts exists")
+	}
+
+	return count > 0, nil
+}
+
+// Publication pointed to by the foreign key.
+func (o *Post) Publication(mods ...qm.QueryMod) publicationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PublicationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Publications(queryMods...)
+	queries.SetFrom(query.Query, "\"publications\"")
+
+	return query
+}
+
+// SeriesPost pointed to by the foreign key.
+func (o *Post) SeriesPost(mods ...qm.QueryMod) seriesPostQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"post_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := SeriesPosts(queryMods...)
+	queries.SetFrom(query.Query, "\"series_posts\"")
+
+	return query
+}
+
+// Highlights retrieves all the highlight's Highlights with an executor.
+func (o *Post) Highlights(mods ...qm.QueryMod) highlightQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"highlights\".\"post_id\"=?", o.ID),
+	)
+
+	query := Highlights(queryMods...)
+	queries.SetFrom(query.Query, "\"highlights\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"highlights\".*"})
+	}
+
+	return query
+}
+
+// MeteredReads retrieves all the metered_read's MeteredReads with an executor.
+func (o *Post) MeteredReads(mods ...qm.QueryMod) meteredReadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"metered_reads\".\"post_id\"=?", o.ID),
+	)
+
+	query := MeteredReads(queryMods...)
+	queries.SetFrom(query.Query, "\"metered_reads\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"metered_reads\".*"})
+	}
+
+	return query
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *Post) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notifications\".\"post_id\"=?", o.ID),
+	)
+
+	query := Notifications(queryMods...)
+	queries.SetFrom(query.Query, "\"notifications\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"notifications\".*"})
+	}
+
+	return query
+}
+
+// PostAuthors retrieves all the post_author's PostAuthors with an executor.
+func (o *Post) PostAuthors(mods ...qm.QueryMod) postAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_authors\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostAuthors(queryMods...)
+	queries.SetFrom(query.Query, "\"post_authors\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_authors\".*"})
+	}
+
+	return query
+}
+
+// PostLikes retrieves all the post_like's PostLikes with an executor.
+func (o *Post) PostLikes(mods ...qm.QueryMod) postLikeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_likes\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostLikes(queryMods...)
+	queries.SetFrom(query.Query, "\"post_likes\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_likes\".*"})
+	}
+
+	return query
+}
+
+// PostMentions retrieves all the post_mention's PostMentions with an executor.
+func (o *Post) PostMentions(mods ...qm.QueryMod) postMentionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_mentions\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostMentions(queryMods...)
+	queries.SetFrom(query.Query, "\"post_mentions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_mentions\".*"})
+	}
+
+	return query
+}
+
+// PostRankings retrieves all the post_ranking's PostRankings with an executor.
+func (o *Post) PostRankings(mods ...qm.QueryMod) postRankingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_rankings\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostRankings(queryMods...)
+	queries.SetFrom(query.Query, "\"post_rankings\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_rankings\".*"})
+	}
+
+	return query
+}
+
+// PostRevisions retrieves all the post_revision's PostRevisions with an executor.
+func (o *Post) PostRevisions(mods ...qm.QueryMod) postRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_revisions\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostRevisions(queryMods...)
+	queries.SetFrom(query.Query, "\"post_revisions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_revisions\".*"})
+	}
+
+	return query
+}
+
+// PostTags retrieves all the post_tag's PostTags with an executor.
+func (o *Post) PostTags(mods ...qm.QueryMod) postTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_tags\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostTags(queryMods...)
+	queries.SetFrom(query.Query, "\"post_tags\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_tags\".*"})
+	}
+
+	return query
+}
+
+// PostViews retrieves all the post_view's PostViews with an executor.
+func (o *Post) PostViews(mods ...qm.QueryMod) postViewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"post_views\".\"post_id\"=?", o.ID),
+	)
+
+	query := PostViews(queryMods...)
+	queries.SetFrom(query.Query, "\"post_views\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"post_views\".*"})
+	}
+
+	return query
+}
+
+// ReadingListPosts retrieves all the reading_list_post's ReadingListPosts with an executor.
+func (o *Post) ReadingListPosts(mods ...qm.QueryMod) readingListPostQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"reading_list_posts\".\"post_id\"=?", o.ID),
+	)
+
+	query := ReadingListPosts(queryMods...)
+	queries.SetFrom(query.Query, "\"reading_list_posts\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"reading_list_posts\".*"})
+	}
+
+	return query
+}
+
+// RelatedPosts retrieves all the related_post's RelatedPosts with an executor.
+func (o *Post) RelatedPosts(mods ...qm.QueryMod) relatedPostQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"related_posts\".\"post_id\"=?", o.ID),
+	)
+
+	query := RelatedPosts(queryMods...)
+	queries.SetFrom(query.Query, "\"related_posts\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"related_posts\".*"})
+	}
+
+	return query
+}
+
+// ShareLinks retrieves all the share_link's ShareLinks with an executor.
+func (o *Post) ShareLinks(mods ...qm.QueryMod) shareLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"share_links\".\"post_id\"=?", o.ID),
+	)
+
+	query := ShareLinks(queryMods...)
+	queries.SetFrom(query.Query, "\"share_links\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"share_links\".*"})
+	}
+
+	return query
+}
+
+// Submissions retrieves all the submission's Submissions with an executor.
+func (o *Post) Submissions(mods ...qm.QueryMod) submissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"submissions\".\"post_id\"=?", o.ID),
+	)
+
+	query := Submissions(queryMods...)
+	queries.SetFrom(query.Query, "\"submissions\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"submissions\".*"})
+	}
+
+	return query
+}
+
+// UploadRefs retrieves all the upload_ref's UploadRefs with an executor.
+func (o *Post) UploadRefs(mods ...qm.QueryMod) uploadRefQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"upload_refs\".\"post_id\"=?", o.ID),
+	)
+
+	query := UploadRefs(queryMods...)
+	queries.SetFrom(query.Query, "\"upload_refs\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"upload_refs\".*"})
+	}
+
+	return query
+}
+
+// LoadPublication allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (postL) LoadPublication(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		if !queries.IsNil(object.PublicationID) {
+			args = append(args, object.PublicationID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PublicationID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PublicationID) {
+				args = append(args, obj.PublicationID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`publications`),
+		qm.WhereIn(`publications.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Publication")
+	}
+
+	var resultSlice []*Publication
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Publication")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for publications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for publications")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Publication = foreign
+		if foreign.R == nil {
+			foreign.R = &publicationR{}
+		}
+		foreign.R.Posts = append(foreign.R.Posts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PublicationID, foreign.ID) {
+				local.R.Publication = foreign
+				if foreign.R == nil {
+					foreign.R = &publicationR{}
+				}
+				foreign.R.Posts = append(foreign.R.Posts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSeriesPost allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (postL) LoadSeriesPost(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`series_posts`),
+		qm.WhereIn(`series_posts.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load SeriesPost")
+	}
+
+	var resultSlice []*SeriesPost
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice SeriesPost")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for series_posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for series_posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SeriesPost = foreign
+		if foreign.R == nil {
+			foreign.R = &seriesPostR{}
+		}
+		foreign.R.Post = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PostID {
+				local.R.SeriesPost = foreign
+				if foreign.R == nil {
+					foreign.R = &seriesPostR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadHighlights allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadHighlights(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`highlights`),
+		qm.WhereIn(`highlights.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load highlights")
+	}
+
+	var resultSlice []*Highlight
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice highlights")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on highlights")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for highlights")
+	}
+
+	if len(highlightAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Highlights = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &highlightR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.Highlights = append(local.R.Highlights, foreign)
+				if foreign.R == nil {
+					foreign.R = &highlightR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMeteredReads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadMeteredReads(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`metered_reads`),
+		qm.WhereIn(`metered_reads.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load metered_reads")
+	}
+
+	var resultSlice []*MeteredRead
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice metered_reads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on metered_reads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for metered_reads")
+	}
+
+	if len(meteredReadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MeteredReads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &meteredReadR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.MeteredReads = append(local.R.MeteredReads, foreign)
+				if foreign.R == nil {
+					foreign.R = &meteredReadR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PostID) {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostAuthors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_authors`),
+		qm.WhereIn(`post_authors.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_authors")
+	}
+
+	var resultSlice []*PostAuthor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_authors")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_authors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_authors")
+	}
+
+	if len(postAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostAuthors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postAuthorR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostAuthors = append(local.R.PostAuthors, foreign)
+				if foreign.R == nil {
+					foreign.R = &postAuthorR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostLikes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostLikes(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_likes`),
+		qm.WhereIn(`post_likes.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_likes")
+	}
+
+	var resultSlice []*PostLike
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_likes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_likes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_likes")
+	}
+
+	if len(postLikeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostLikes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postLikeR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostLikes = append(local.R.PostLikes, foreign)
+				if foreign.R == nil {
+					foreign.R = &postLikeR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostMentions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostMentions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_mentions`),
+		qm.WhereIn(`post_mentions.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_mentions")
+	}
+
+	var resultSlice []*PostMention
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_mentions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_mentions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_mentions")
+	}
+
+	if len(postMentionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostMentions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postMentionR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostMentions = append(local.R.PostMentions, foreign)
+				if foreign.R == nil {
+					foreign.R = &postMentionR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostRankings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostRankings(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_rankings`),
+		qm.WhereIn(`post_rankings.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_rankings")
+	}
+
+	var resultSlice []*PostRanking
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_rankings")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_rankings")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_rankings")
+	}
+
+	if len(postRankingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostRankings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postRankingR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostRankings = append(local.R.PostRankings, foreign)
+				if foreign.R == nil {
+					foreign.R = &postRankingR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_revisions`),
+		qm.WhereIn(`post_revisions.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_revisions")
+	}
+
+	var resultSlice []*PostRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_revisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_revisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_revisions")
+	}
+
+	if len(postRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postRevisionR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostRevisions = append(local.R.PostRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &postRevisionR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_tags`),
+		qm.WhereIn(`post_tags.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_tags")
+	}
+
+	var resultSlice []*PostTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_tags")
+	}
+
+	if len(postTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postTagR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostTags = append(local.R.PostTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &postTagR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPostViews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadPostViews(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`post_views`),
+		qm.WhereIn(`post_views.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load post_views")
+	}
+
+	var resultSlice []*PostView
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice post_views")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on post_views")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for post_views")
+	}
+
+	if len(postViewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PostViews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postViewR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.PostViews = append(local.R.PostViews, foreign)
+				if foreign.R == nil {
+					foreign.R = &postViewR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReadingListPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadReadingListPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`reading_list_posts`),
+		qm.WhereIn(`reading_list_posts.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reading_list_posts")
+	}
+
+	var resultSlice []*ReadingListPost
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reading_list_posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reading_list_posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reading_list_posts")
+	}
+
+	if len(readingListPostAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReadingListPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &readingListPostR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.ReadingListPosts = append(local.R.ReadingListPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &readingListPostR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRelatedPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadRelatedPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`related_posts`),
+		qm.WhereIn(`related_posts.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load related_posts")
+	}
+
+	var resultSlice []*RelatedPost
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice related_posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on related_posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for related_posts")
+	}
+
+	if len(relatedPostAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RelatedPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &relatedPostR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.RelatedPosts = append(local.R.RelatedPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &relatedPostR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadShareLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadShareLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`share_links`),
+		qm.WhereIn(`share_links.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load share_links")
+	}
+
+	var resultSlice []*ShareLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice share_links")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on share_links")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for share_links")
+	}
+
+	if len(shareLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ShareLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &shareLinkR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.ShareLinks = append(local.R.ShareLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &shareLinkR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSubmissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadSubmissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`submissions`),
+		qm.WhereIn(`submissions.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load submissions")
+	}
+
+	var resultSlice []*Submission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice submissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on submissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for submissions")
+	}
+
+	if len(submissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Submissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &submissionR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.Submissions = append(local.R.Submissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &submissionR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploadRefs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (postL) LoadUploadRefs(ctx context.Context, e boil.ContextExecutor, singular bool, maybePost interface{}, mods queries.Applicator) error {
+	var slice []*Post
+	var object *Post
+
+	if singular {
+		object = maybePost.(*Post)
+	} else {
+		slice = *maybePost.(*[]*Post)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &postR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &postR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`upload_refs`),
+		qm.WhereIn(`upload_refs.post_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load upload_refs")
+	}
+
+	var resultSlice []*UploadRef
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice upload_refs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on upload_refs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for upload_refs")
+	}
+
+	if len(uploadRefAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UploadRefs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadRefR{}
+			}
+			foreign.R.Post = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PostID {
+				local.R.UploadRefs = append(local.R.UploadRefs, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadRefR{}
+				}
+				foreign.R.Post = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPublication of the post to the related item.
+// Sets o.R.Publication to related.
+// Adds o to related.R.Posts.
+func (o *Post) SetPublication(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Publication) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"posts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"publication_id"}),
+		strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PublicationID, related.ID)
+	if o.R == nil {
+		o.R = &postR{
+			Publication: related,
+		}
+	} else {
+		o.R.Publication = related
+	}
+
+	if related.R == nil {
+		related.R = &publicationR{
+			Posts: PostSlice{o},
+		}
+	} else {
+		related.R.Posts = append(related.R.Posts, o)
+	}
+
+	return nil
+}
+
+// RemovePublication relationship.
+// Sets o.R.Publication to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *Post) RemovePublication(ctx context.Context, exec boil.ContextExecutor, related *Publication) error {
+	var err error
+
+	queries.SetScanner(&o.PublicationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("publication_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Publication = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Posts {
+		if queries.Equal(o.PublicationID, ri.PublicationID) {
+			continue
+		}
+
+		ln := len(related.R.Posts)
+		if ln > 1 && i < ln-1 {
+			related.R.Posts[i] = related.R.Posts[ln-1]
+		}
+		related.R.Posts = related.R.Posts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSeriesPost of the post to the related item.
+// Sets o.R.SeriesPost to related.
+// Adds o to related.R.Post.
+func (o *Post) SetSeriesPost(ctx context.Context, exec boil.ContextExecutor, insert bool, related *SeriesPost) error {
+	var err error
+
+	if insert {
+		related.PostID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"series_posts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+			strmangle.WhereClause("\"", "\"", 2, seriesPostPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.SeriesID, related.PostID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PostID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			SeriesPost: related,
+		}
+	} else {
+		o.R.SeriesPost = related
+	}
+
+	if related.R == nil {
+		related.R = &seriesPostR{
+			Post: o,
+		}
+	} else {
+		related.R.Post = o
+	}
+	return nil
+}
+
+// AddHighlights adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Highlights.
+// Sets related.R.Post appropriately.
+func (o *Post) AddHighlights(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Highlight) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"highlights\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, highlightPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Highlights: related,
+		}
+	} else {
+		o.R.Highlights = append(o.R.Highlights, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &highlightR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddMeteredReads adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.MeteredReads.
+// Sets related.R.Post appropriately.
+func (o *Post) AddMeteredReads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MeteredRead) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"metered_reads\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, meteredReadPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Month, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			MeteredReads: related,
+		}
+	} else {
+		o.R.MeteredReads = append(o.R.MeteredReads, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &meteredReadR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.Post appropriately.
+func (o *Post) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PostID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PostID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// SetNotifications removes all previously related items of the
+// post replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Post's Notifications accordingly.
+// Replaces o.R.Notifications with related.
+// Sets related.R.Post's Notifications accordingly.
+func (o *Post) SetNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update \"notifications\" set \"post_id\" = null where \"post_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Notifications {
+			queries.SetScanner(&rel.PostID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Post = nil
+		}
+
+		o.R.Notifications = nil
+	}
+	return o.AddNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveNotifications relationships from objects passed in.
+// Removes related items from R.Notifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Post.
+func (o *Post) RemoveNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PostID, nil)
+		if rel.R != nil {
+			rel.R.Post = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("post_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Notifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Notifications)
+			if ln > 1 && i < ln-1 {
+				o.R.Notifications[i] = o.R.Notifications[ln-1]
+			}
+			o.R.Notifications = o.R.Notifications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPostAuthors adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostAuthors.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_authors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostAuthors: related,
+		}
+	} else {
+		o.R.PostAuthors = append(o.R.PostAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postAuthorR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddPostLikes adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostLikes.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostLikes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostLike) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_likes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postLikePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostLikes: related,
+		}
+	} else {
+		o.R.PostLikes = append(o.R.PostLikes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postLikeR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
+}
+
+// AddPostMentions adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostMentions.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostMentions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostMention) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_mentions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postMentionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &postR{
+			PostMentions: related,
+		}
+	} else {
+		o.R.PostMentions = append(o.R.PostMentions, related...)
 	}
 
-	for _, hook := range postBeforeUpsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postMentionR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
 		}
 	}
-
 	return nil
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *Post) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
+// AddPostRankings adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostRankings.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostRankings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostRanking) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_rankings\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postRankingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Period, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
 	}
 
-	for _, hook := range postAfterInsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+	if o.R == nil {
+		o.R = &postR{
+			PostRankings: related,
 		}
+	} else {
+		o.R.PostRankings = append(o.R.PostRankings, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postRankingR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *Post) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
+// AddPostRevisions adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostRevisions.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_revisions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
 	}
 
-	for _, hook := range postAfterSelectHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+	if o.R == nil {
+		o.R = &postR{
+			PostRevisions: related,
 		}
+	} else {
+		o.R.PostRevisions = append(o.R.PostRevisions, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postRevisionR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *Post) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
+// AddPostTags adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostTags.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_tags\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID, rel.TagID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
 	}
 
-	for _, hook := range postAfterUpdateHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+	if o.R == nil {
+		o.R = &postR{
+			PostTags: related,
 		}
+	} else {
+		o.R.PostTags = append(o.R.PostTags, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postTagR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *Post) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
+// AddPostViews adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.PostViews.
+// Sets related.R.Post appropriately.
+func (o *Post) AddPostViews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PostView) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"post_views\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, postViewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID, rel.Day}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PostID = o.ID
+		}
 	}
 
-	for _, hook := range postAfterDeleteHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+	if o.R == nil {
+		o.R = &postR{
+			PostViews: related,
 		}
+	} else {
+		o.R.PostViews = append(o.R.PostViews, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postViewR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
 	return nil
 }
 
-// doAfterUpsertHooks executes all "after Upsert" hooks.
-func (o *Post) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
-		return nil
-	}
+// AddReadingListPosts adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.ReadingListPosts.
+// Sets related.R.Post appropriately.
+func (o *Post) AddReadingListPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ReadingListPost) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"reading_list_posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, readingListPostPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ListID, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	for _, hook := range postAfterUpsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
+			rel.PostID = o.ID
 		}
 	}
 
-	return nil
-}
+	if o.R == nil {
+		o.R = &postR{
+			ReadingListPosts: related,
+		}
+	} else {
+		o.R.ReadingListPosts = append(o.R.ReadingListPosts, related...)
+	}
 
-// AddPostHook registers your hook function for all future operations.
-func AddPostHook(hookPoint boil.HookPoint, postHook PostHook) {
-	switch hookPoint {
-	case boil.BeforeInsertHook:
-		postBeforeInsertHooks = append(postBeforeInsertHooks, postHook)
-	case boil.BeforeUpdateHook:
-		postBeforeUpdateHooks = append(postBeforeUpdateHooks, postHook)
-	case boil.BeforeDeleteHook:
-		postBeforeDeleteHooks = append(postBeforeDeleteHooks, postHook)
-	case boil.BeforeUpsertHook:
-		postBeforeUpsertHooks = append(postBeforeUpsertHooks, postHook)
-	case boil.AfterInsertHook:
-		postAfterInsertHooks = append(postAfterInsertHooks, postHook)
-	case boil.AfterSelectHook:
-		postAfterSelectHooks = append(postAfterSelectHooks, postHook)
-	case boil.AfterUpdateHook:
-		postAfterUpdateHooks = append(postAfterUpdateHooks, postHook)
-	case boil.AfterDeleteHook:
-		postAfterDeleteHooks = append(postAfterDeleteHooks, postHook)
-	case boil.AfterUpsertHook:
-		postAfterUpsertHooks = append(postAfterUpsertHooks, postHook)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &readingListPostR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
 	}
+	return nil
 }
 
-// One returns a single post record from the query.
-func (q postQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Post, error) {
-	o := &Post{}
-
-	queries.SetLimit(q.Query, 1)
+// AddRelatedPosts adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.RelatedPosts.
+// Sets related.R.Post appropriately.
+func (o *Post) AddRelatedPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RelatedPost) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"related_posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, relatedPostPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	err := q.Bind(ctx, exec, o)
-	if err != nil {
-		if errors.Cause(err) == sql.ErrNoRows {
-			return nil, sql.ErrNoRows
+			rel.PostID = o.ID
 		}
-		return nil, errors.Wrap(err, "models: failed to execute a one query for posts")
 	}
 
-	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
-		return o, err
+	if o.R == nil {
+		o.R = &postR{
+			RelatedPosts: related,
+		}
+	} else {
+		o.R.RelatedPosts = append(o.R.RelatedPosts, related...)
 	}
 
-	return o, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &relatedPostR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
 }
 
-// All returns all Post records from the query.
-func (q postQuery) All(ctx context.Context, exec boil.ContextExecutor) (PostSlice, error) {
-	var o []*Post
+// AddShareLinks adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.ShareLinks.
+// Sets related.R.Post appropriately.
+func (o *Post) AddShareLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ShareLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"share_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, shareLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	err := q.Bind(ctx, exec, &o)
-	if err != nil {
-		return nil, errors.Wrap(err, "models: failed to assign all query results to Post slice")
+			rel.PostID = o.ID
+		}
 	}
 
-	if len(postAfterSelectHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
-				return o, err
-			}
+	if o.R == nil {
+		o.R = &postR{
+			ShareLinks: related,
 		}
+	} else {
+		o.R.ShareLinks = append(o.R.ShareLinks, related...)
 	}
 
-	return o, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &shareLinkR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
 }
 
-// Count returns the count of all Post records in the query.
-func (q postQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	var count int64
+// AddSubmissions adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.Submissions.
+// Sets related.R.Post appropriately.
+func (o *Post) AddSubmissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Submission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"submissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, submissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
+			rel.PostID = o.ID
+		}
+	}
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to count posts rows")
+	if o.R == nil {
+		o.R = &postR{
+			Submissions: related,
+		}
+	} else {
+		o.R.Submissions = append(o.R.Submissions, related...)
 	}
 
-	return count, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &submissionR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
 }
 
-// Exists checks if the row exists in the table.
-func (q postQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	var count int64
+// AddUploadRefs adds the given related objects to the existing relationships
+// of the post, optionally inserting them as new records.
+// Appends related to o.R.UploadRefs.
+// Sets related.R.Post appropriately.
+func (o *Post) AddUploadRefs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UploadRef) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PostID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"upload_refs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"post_id"}),
+				strmangle.WhereClause("\"", "\"", 2, uploadRefPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Hash, rel.PostID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
-	queries.SetLimit(q.Query, 1)
+			rel.PostID = o.ID
+		}
+	}
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
-	if err != nil {
-		return false, errors.Wrap(err, "models: failed to check if posts exists")
+	if o.R == nil {
+		o.R = &postR{
+			UploadRefs: related,
+		}
+	} else {
+		o.R.UploadRefs = append(o.R.UploadRefs, related...)
 	}
 
-	return count > 0, nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &uploadRefR{
+				Post: o,
+			}
+		} else {
+			rel.R.Post = o
+		}
+	}
+	return nil
 }
 
 // Posts retrieves all the records using an executor.
 func Posts(mods ...qm.QueryMod) postQuery {
-	mods = append(mods, qm.From("\"posts\""))
+	mods = append(mods, qm.From("\"posts\""), qmhelper.WhereIsNull("\"posts\".\"deleted_at\""))
 	return postQuery{NewQuery(mods...)}
 }
 
@@ -514,7 +3547,7 @@ func FindPost(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"posts\" where \"id\"=$1", sel,
+		"select %s from \"posts\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -878,7 +3911,7 @@ func (o *Post) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 
 // Delete deletes a single Post record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Post) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Post) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Post provided for delete")
 	}
@@ -887,8 +3920,26 @@ func (o *Post) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postPrimaryKeyMapping)
-	sql := "DELETE FROM \"posts\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), postPrimaryKeyMapping)
+		sql = "DELETE FROM \"posts\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"posts\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(postType, postMapping, append(wl, postPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -913,12 +3964,17 @@ func (o *Post) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 }
 
 // DeleteAll deletes all matching rows.
-func (q postQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q postQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no postQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -934,7 +3990,7 @@ func (q postQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o PostSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o PostSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -947,15 +4003,32 @@ func (o PostSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"posts\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), postPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"posts\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, postPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"posts\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1009,7 +4082,8 @@ func (o *PostSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) er
 	}
 
 	sql := "SELECT \"posts\".* FROM \"posts\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, postPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1026,7 +4100,7 @@ func (o *PostSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) er
 // PostExists checks if the Post row exists.
 func PostExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"posts\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"posts\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -6,9 +6,77 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("CommentMentions", testCommentMentionsUpsert)
+
 	t.Run("GorpMigrations", testGorpMigrationsUpsert)
 
+	t.Run("Highlights", testHighlightsUpsert)
+
+	t.Run("MeteredReads", testMeteredReadsUpsert)
+
+	t.Run("NotificationActors", testNotificationActorsUpsert)
+
+	t.Run("NotificationPreferences", testNotificationPreferencesUpsert)
+
+	t.Run("Notifications", testNotificationsUpsert)
+
+	t.Run("PostAuthors", testPostAuthorsUpsert)
+
+	t.Run("PostLikes", testPostLikesUpsert)
+
+	t.Run("PostMentions", testPostMentionsUpsert)
+
+	t.Run("PostRankings", testPostRankingsUpsert)
+
+	t.Run("PostRevisions", testPostRevisionsUpsert)
+
+	t.Run("PostTags", testPostTagsUpsert)
+
+	t.Run("PostViews", testPostViewsUpsert)
+
 	t.Run("Posts", testPostsUpsert)
 
+	t.Run("PublicationMembers", testPublicationMembersUpsert)
+
+	t.Run("Publications", testPublicationsUpsert)
+
+	t.Run("ReadingListPosts", testReadingListPostsUpsert)
+
+	t.Run("ReadingLists", testReadingListsUpsert)
+
+	t.Run("RelatedPosts", testRelatedPostsUpsert)
+
+	t.Run("AllSeries", testAllSeriesUpsert)
+
+	t.Run("SeriesPosts", testSeriesPostsUpsert)
+
+	t.Run("ShareLinkAccesses", testShareLinkAccessesUpsert)
+
+	t.Run("ShareLinkComments", testShareLinkCommentsUpsert)
+
+	t.Run("ShareLinks", testShareLinksUpsert)
+
+	t.Run("Submissions", testSubmissionsUpsert)
+
+	t.Run("Subscriptions", testSubscriptionsUpsert)
+
+	t.Run("TagAliases", testTagAliasesUpsert)
+
+	t.Run("TagFollows", testTagFollowsUpsert)
+
+	t.Run("Tags", testTagsUpsert)
+
+	t.Run("UploadRefs", testUploadRefsUpsert)
+
+	t.Run("UploadVariants", testUploadVariantsUpsert)
+
+	t.Run("Uploads", testUploadsUpsert)
+
+	t.Run("UserBlocks", testUserBlocksUpsert)
+
+	t.Run("UserFollows", testUserFollowsUpsert)
+
+	t.Run("UserUploadRefs", testUserUploadRefsUpsert)
+
 	t.Run("Users", testUsersUpsert)
 }
@@ -0,0 +1,104 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Billing periods of a plan
+const (
+	Monthly = "month"
+	Yearly  = "year"
+)
+
+// DeclinedToken is the payment token the fake provider always declines
+const DeclinedToken = "tok_declined"
+
+// ErrDeclined is returned when the provider refuses to charge the payment method
+var ErrDeclined = errors.New("Payment declined.")
+
+// ErrUnknownSubscription is returned when the provider has no subscription with an ID
+var ErrUnknownSubscription = errors.New("Subscription not found.")
+
+// Plan is what a reader subscribes to
+type Plan struct {
+	Tier   string
+	Period string
+}
+
+// Subscription is a subscription as known to the payment provider
+type Subscription struct {
+	ID               string
+	CurrentPeriodEnd time.Time
+}
+
+// Provider charges readers for their subscriptions
+type Provider interface {
+	// Subscribe charges the payment method behind token for the first period of a plan
+	Subscribe(ctx context.Context, customer string, plan Plan, token string) (*Subscription, error)
+	// Cancel stops renewing a subscription. The period already paid for is not refunded
+	Cancel(ctx context.Context, id string) error
+}
+
+// New returns the provider with the given name
+func New(name string) (Provider, error) {
+	switch name {
+	case "", "fake":
+		return NewFake(time.Now), nil
+	}
+	return nil, fmt.Errorf("unknown payment provider %q", name)
+}
+
+// Fake is an in-memory provider for development and tests. It accepts every
+// payment token except DeclinedToken and never renews subscriptions
+type Fake struct {
+	now           func() time.Time
+	mu            sync.Mutex
+	next          int
+	subscriptions map[string]*fakeSubscription
+}
+
+type fakeSubscription struct {
+	customer string
+	plan     Plan
+	canceled bool
+}
+
+// NewFake returns a fake provider reading the time from now
+func NewFake(now func() time.Time) *Fake {
+	return &Fake{now: now, subscriptions: map[string]*fakeSubscription{}}
+}
+
+// Subscribe implements Provider
+func (f *Fake) Subscribe(ctx context.Context, customer string, plan Plan, token string) (*Subscription, error) {
+	if token == DeclinedToken {
+		return nil, ErrDeclined
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.next++
+	id := fmt.Sprintf("fake_sub_%d", f.next)
+	f.subscriptions[id] = &fakeSubscription{customer: customer, plan: plan}
+
+	end := f.now().AddDate(0, 1, 0)
+	if plan.Period == Yearly {
+		end = f.now().AddDate(1, 0, 0)
+	}
+	return &Subscription{ID: id, CurrentPeriodEnd: end}, nil
+}
+
+// Cancel implements Provider
+func (f *Fake) Cancel(ctx context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, ok := f.subscriptions[id]
+	if !ok {
+		return ErrUnknownSubscription
+	}
+	s.canceled = true
+	return nil
+}
@@ -8,27 +8,140 @@ import (
 	"github.com/json9512/mediumclone-backendwithgo/src/api"
 	"github.com/json9512/mediumclone-backendwithgo/src/config"
 	"github.com/json9512/mediumclone-backendwithgo/src/middlewares"
+	"github.com/json9512/mediumclone-backendwithgo/src/payments"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
 )
 
 // AddRoutes adds available routes to the provided router
-func AddRoutes(router *gin.Engine, db *sql.DB, env *config.EnvVars) {
+func AddRoutes(router *gin.Engine, db *sql.DB, env *config.EnvVars, provider payments.Provider, store storage.BlobStore, broker *stream.Broker) {
 	apiGroup := router.Group("/api/v1")
 	{
 		apiGroup.POST("/login", api.Login(db, env))
 		apiGroup.POST("/logout", middlewares.VerifyUser(db), api.Logout(db))
 
 		posts := apiGroup.Group("/posts")
-		posts.GET("", api.GetPosts(db))
-		posts.GET(":id", api.GetPost(db))
-		posts.GET(":id/like", api.GetLikesForPost(db))
+		posts.GET("", middlewares.IdentifyUser(db), api.GetPosts(db))
+		posts.GET(":id", middlewares.IdentifyUser(db), dispatchParam("id", map[string]gin.HandlerFunc{
+			"trending": api.GetTrendingPosts(db),
+		}, api.GetPost(db, env)))
+		posts.GET(":id/like", middlewares.IdentifyUser(db), api.GetLikesForPost(db))
+		posts.POST(":id/like", middlewares.VerifyUser(db), api.LikePost(db))
+		posts.DELETE(":id/like", middlewares.VerifyUser(db), api.UnlikePost(db))
+		posts.GET(":id/related", middlewares.IdentifyUser(db), api.GetRelatedPosts(db))
+		posts.GET(":id/highlights", middlewares.IdentifyUser(db), api.GetHighlights(db, env))
+		posts.POST(":id/highlights", middlewares.VerifyUser(db), api.CreateHighlight(db, env))
+		posts.PUT(":id/highlights/:highlight_id", middlewares.VerifyUser(db), api.UpdateHighlight(db))
+		posts.DELETE(":id/highlights/:highlight_id", middlewares.VerifyUser(db), api.DeleteHighlight(db))
+		posts.GET(":id/authors", middlewares.VerifyUser(db), api.GetCoAuthors(db))
+		posts.POST(":id/authors", middlewares.VerifyUser(db), api.InviteCoAuthor(db))
+		posts.PUT(":id/authors", middlewares.VerifyUser(db), api.ReorderCoAuthors(db))
+		posts.POST(":id/authors/accept", middlewares.VerifyUser(db), api.AcceptCoAuthorship(db))
+		posts.DELETE(":id/authors/:user_id", middlewares.VerifyUser(db), api.RemoveCoAuthor(db))
+		posts.GET(":id/shares", middlewares.VerifyUser(db), api.GetShareLinks(db))
+		posts.POST(":id/shares", middlewares.VerifyUser(db), api.CreateShareLink(db))
+		posts.GET(":id/shares/:share_id", middlewares.VerifyUser(db), api.GetShareLink(db))
+		posts.DELETE(":id/shares/:share_id", middlewares.VerifyUser(db), api.RevokeShareLink(db))
+		posts.POST(":id/bookmark", middlewares.VerifyUser(db), api.BookmarkPost(db))
+		posts.DELETE(":id/bookmark", middlewares.VerifyUser(db), api.UnbookmarkPost(db))
 		posts.POST("", middlewares.VerifyUser(db), api.CreatePost(db))
 		posts.PUT("", middlewares.VerifyUser(db), api.UpdatePost(db))
 		posts.DELETE(":id", middlewares.VerifyUser(db), api.DeletePost(db))
+		posts.POST(":id/restore", middlewares.VerifyUser(db), api.RestorePost(db))
+		posts.PUT(":id/schedule", middlewares.VerifyUser(db), api.SchedulePost(db))
+		posts.DELETE(":id/schedule", middlewares.VerifyUser(db), api.CancelScheduledPost(db))
+		posts.GET(":id/revisions", middlewares.IdentifyUser(db), api.GetRevisions(db, env))
+		posts.GET(":id/revisions/:rev", middlewares.IdentifyUser(db), api.GetRevision(db, env))
+		posts.POST(":id/revisions/:rev/restore", middlewares.VerifyUser(db), api.RestoreRevision(db))
+		posts.GET(":id/diff", middlewares.IdentifyUser(db), api.GetRevisionDiff(db, env))
+
+		shared := apiGroup.Group("/shared")
+		shared.GET(":token", api.GetSharedPost(db))
+		shared.POST(":token/comments", middlewares.IdentifyUser(db), api.CommentOnSharedPost(db))
+
+		apiGroup.GET("/search", api.Search(db))
+		apiGroup.GET("/search/suggest", api.Suggest(db))
+
+		tags := apiGroup.Group("/tags")
+		tags.GET("", api.GetTags(db))
+		tags.GET(":slug", api.GetTag(db))
+		tags.POST(":slug/follow", middlewares.VerifyUser(db), api.FollowTag(db))
+		tags.DELETE(":slug/follow", middlewares.VerifyUser(db), api.UnfollowTag(db))
+		tags.POST(":slug/aliases", middlewares.VerifyUser(db), middlewares.VerifyAdmin(db), api.AddTagAlias(db))
+		tags.DELETE(":slug/aliases/:alias", middlewares.VerifyUser(db), middlewares.VerifyAdmin(db), api.RemoveTagAlias(db))
+
+		lists := apiGroup.Group("/lists")
+		lists.POST("", middlewares.VerifyUser(db), api.CreateList(db))
+		lists.GET(":id", middlewares.IdentifyUser(db), api.GetList(db))
+		lists.DELETE(":id", middlewares.VerifyUser(db), api.DeleteList(db))
+		lists.POST(":id/posts", middlewares.VerifyUser(db), api.AddPostToList(db))
+		lists.PUT(":id/posts", middlewares.VerifyUser(db), api.ReorderList(db))
+		lists.DELETE(":id/posts/:post_id", middlewares.VerifyUser(db), api.RemovePostFromList(db))
+
+		series := apiGroup.Group("/series")
+		series.POST("", middlewares.VerifyUser(db), api.CreateSeries(db))
+		series.GET(":id", middlewares.IdentifyUser(db), api.GetSeries(db))
+		series.POST(":id/posts", middlewares.VerifyUser(db), api.AddPostToSeries(db))
+		series.PUT(":id/posts", middlewares.VerifyUser(db), api.ReorderSeries(db))
+		series.DELETE(":id/posts/:post_id", middlewares.VerifyUser(db), api.RemovePostFromSeries(db))
+
+		publications := apiGroup.Group("/publications")
+		publications.POST("", middlewares.VerifyUser(db), api.CreatePublication(db))
+		publications.GET(":slug", api.GetPublication(db))
+		publications.PUT(":slug/members", middlewares.VerifyUser(db), api.SetPublicationMember(db))
+		publications.DELETE(":slug/members/:user_id", middlewares.VerifyUser(db), api.RemovePublicationMember(db))
+		publications.GET(":slug/submissions", middlewares.VerifyUser(db), api.GetSubmissions(db))
+		publications.POST(":slug/submissions", middlewares.VerifyUser(db), api.SubmitPost(db))
+		publications.POST(":slug/submissions/:submission_id/approve", middlewares.VerifyUser(db), api.ApproveSubmission(db))
+		publications.POST(":slug/submissions/:submission_id/reject", middlewares.VerifyUser(db), api.RejectSubmission(db))
+
+		subscriptions := apiGroup.Group("/subscriptions")
+		subscriptions.POST("", middlewares.VerifyUser(db), api.Subscribe(db, provider))
+		subscriptions.GET("me", middlewares.VerifyUser(db), api.GetSubscription(db))
+		subscriptions.DELETE("me", middlewares.VerifyUser(db), api.CancelSubscription(db, provider))
+
+		uploads := apiGroup.Group("/uploads")
+		uploads.POST("", middlewares.VerifyUser(db), api.UploadImage(db, store, env))
+		uploads.GET("", middlewares.VerifyUser(db), api.GetUploads(db, env))
+		uploads.GET(":name", api.GetUpload(db, store))
+
+		notifications := apiGroup.Group("/notifications", middlewares.VerifyUser(db))
+		notifications.GET("", api.GetNotifications(db))
+		notifications.GET("preferences", api.GetNotificationPreferences(db))
+		notifications.PUT("preferences", api.UpdateNotificationPreferences(db))
+		notifications.POST(":id/read", dispatchParam("id", map[string]gin.HandlerFunc{
+			"all": api.MarkAllNotificationsRead(db),
+		}, api.MarkNotificationRead(db)))
+
+		apiGroup.GET("/stream", middlewares.VerifyUser(db), api.Stream(db, env, broker))
+
+		admin := apiGroup.Group("/admin", middlewares.VerifyUser(db), middlewares.VerifyAdmin(db))
+		admin.GET("uploads/orphans", api.GetOrphanedUploads(db, env))
 
 		users := apiGroup.Group("/users")
 		users.GET(":id", api.RetrieveUser(db))
+		users.GET(":id/trash", middlewares.VerifyUser(db), api.GetTrash(db))
+		users.GET(":id/invitations", middlewares.VerifyUser(db), api.GetInvitations(db))
+		users.GET(":id/tags", api.GetFollowedTags(db))
+		users.GET(":id/lists", middlewares.IdentifyUser(db), api.GetUserLists(db))
+		users.POST(":id/follow", middlewares.VerifyUser(db), api.FollowUser(db))
+		users.DELETE(":id/follow", middlewares.VerifyUser(db), api.UnfollowUser(db))
+		users.POST(":id/block", middlewares.VerifyUser(db), api.BlockUser(db))
+		users.DELETE(":id/block", middlewares.VerifyUser(db), api.UnblockUser(db))
 		users.POST("", api.RegisterUser(db))
 		users.PUT("", middlewares.VerifyUser(db), api.UpdateUser(db))
 		users.DELETE(":id", middlewares.VerifyUser(db), api.DeleteUser(db))
 	}
 }
+
+// dispatchParam serves paths whose parameter is one of the static names with their own handler.
+// The router cannot register a static segment next to a parameter at the same level
+func dispatchParam(param string, static map[string]gin.HandlerFunc, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if handler, ok := static[c.Param(param)]; ok {
+			handler(c)
+			return
+		}
+		fallback(c)
+	}
+}
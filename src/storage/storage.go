@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ErrNotFound is returned when a blob does not exist
+var ErrNotFound = errors.New("Blob not found.")
+
+// BlobStore keeps the bytes of uploaded files under keys chosen by the caller
+type BlobStore interface {
+	// Put stores data under key, replacing any blob with the same key
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get opens the blob stored under key. The caller closes it
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Config selects and configures a blob store
+type Config struct {
+	// Backend is "local" or "s3"
+	Backend string
+	// Dir is the root directory of the local backend
+	Dir string
+	S3  S3Config
+}
+
+// New returns the blob store described by cfg
+func New(cfg Config) (BlobStore, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocal(cfg.Dir)
+	case "s3":
+		return NewS3(cfg.S3)
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+}
+
+// ImageKey returns the key of the image with a hash, spread over directories by its first characters,
+// or the key of its variant when width is positive
+func ImageKey(hash string, width int) string {
+	key := "images/" + hash[:2] + "/" + hash
+	if width > 0 {
+		key += "-w" + strconv.Itoa(width)
+	}
+	return key
+}
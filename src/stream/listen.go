@@ -0,0 +1,60 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// Channel is the PostgreSQL notification channel events are published on,
+// so that every server instance receives the events of the others
+const Channel = "stream_events"
+
+// pingInterval is how long the listener waits for a notification before checking its connection
+const pingInterval = 90 * time.Second
+
+// Listen forwards the events notified on Channel to the broker until ctx is cancelled.
+// It connects in the background, retrying until the database is reachable. Notifications sent
+// while the connection is lost cannot be recovered, so the broker is reset when the listener reconnects
+func Listen(ctx context.Context, dataSource string, b *Broker, l *logrus.Logger) {
+	logger := l.WithField("channel", Channel)
+	listener := pq.NewListener(dataSource, time.Second, time.Minute, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error(err)
+		}
+	})
+
+	go func() {
+		defer listener.Close()
+		if err := listener.Listen(Channel); err != nil {
+			logger.Error(err)
+			return
+		}
+
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// A nil notification means the connection was re-established
+				if n == nil {
+					b.Reset()
+					continue
+				}
+				var e Event
+				if err := json.Unmarshal([]byte(n.Extra), &e); err != nil {
+					logger.Error(err)
+					continue
+				}
+				b.Publish(e)
+			case <-ticker.C:
+				go listener.Ping()
+			}
+		}
+	}()
+}
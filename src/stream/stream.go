@@ -0,0 +1,151 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Event types
+const (
+	EventLikes        = "likes"
+	EventComment      = "comment"
+	EventNotification = "notification"
+	// EventReset tells a client it may have missed events and should refetch what it shows
+	EventReset = "reset"
+)
+
+// Event is a real-time update published on a topic
+type Event struct {
+	ID    int64           `json:"id"`
+	Topic string          `json:"topic"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// PostLikesTopic is the topic of the like count of a post
+func PostLikesTopic(postID int) string {
+	return fmt.Sprintf("post:%d:likes", postID)
+}
+
+// PostCommentsTopic is the topic of the comments left on a post
+func PostCommentsTopic(postID int) string {
+	return fmt.Sprintf("post:%d:comments", postID)
+}
+
+// NotificationsTopic is the topic of the notifications of a user
+func NotificationsTopic(userID int) string {
+	return fmt.Sprintf("user:%d:notifications", userID)
+}
+
+// Subscription receives the events published on its topics
+type Subscription struct {
+	topics map[string]bool
+	events chan Event
+}
+
+// Events returns the channel the events are delivered on. It is closed when the subscriber
+// falls behind or the broker is reset, after which the client should reconnect
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Broker fans out the events of this server instance to its subscribers
+// and keeps the most recent ones for clients resuming a stream
+type Broker struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]bool
+	replay []Event
+	size   int
+	queue  int
+}
+
+// NewBroker returns a broker replaying up to replaySize events and queueing up to queueSize
+// events per subscriber
+func NewBroker(replaySize int, queueSize int) *Broker {
+	return &Broker{
+		subs:  map[*Subscription]bool{},
+		size:  replaySize,
+		queue: queueSize,
+	}
+}
+
+// Subscribe registers a subscription to topics. When lastEventID is not zero, the events
+// on the topics published after it are returned to be sent before the live ones.
+// ok is false when that event is no longer buffered, so the client may have missed events
+func (b *Broker) Subscribe(topics []string, lastEventID int64) (sub *Subscription, replay []Event, ok bool) {
+	sub = &Subscription{topics: map[string]bool{}, events: make(chan Event, b.queue)}
+	for _, t := range topics {
+		sub.topics[t] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[sub] = true
+
+	if lastEventID == 0 {
+		return sub, nil, true
+	}
+	// Events are buffered in the order they were committed, which may differ from the order of their IDs
+	for i := len(b.replay) - 1; i >= 0; i-- {
+		if b.replay[i].ID != lastEventID {
+			continue
+		}
+		for _, e := range b.replay[i+1:] {
+			if sub.topics[e.Topic] {
+				replay = append(replay, e)
+			}
+		}
+		return sub, replay, true
+	}
+	return sub, nil, false
+}
+
+// Unsubscribe removes a subscription from the broker
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.drop(sub)
+}
+
+// Publish delivers an event to the subscribers of its topic. A subscriber whose queue is full
+// is dropped rather than holding up the others, and resumes from the replay buffer once it reconnects
+func (b *Broker) Publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.replay = append(b.replay, e)
+	if len(b.replay) > b.size {
+		b.replay = append(b.replay[:0], b.replay[len(b.replay)-b.size:]...)
+	}
+
+	for sub := range b.subs {
+		if !sub.topics[e.Topic] {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			b.drop(sub)
+		}
+	}
+}
+
+// Reset forgets the buffered events and drops every subscriber.
+// It is used when events may have been lost, so that clients reconnect and refetch
+func (b *Broker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.replay = nil
+	for sub := range b.subs {
+		b.drop(sub)
+	}
+}
+
+func (b *Broker) drop(sub *Subscription) {
+	if b.subs[sub] {
+		delete(b.subs, sub)
+		close(sub.events)
+	}
+}
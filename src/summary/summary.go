@@ -0,0 +1,205 @@
+package summary
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
+)
+
+const (
+	// wordsPerMinute is the reading speed for space delimited languages
+	wordsPerMinute = 265
+	// charactersPerMinute is the reading speed for Chinese and Japanese,
+	// which are counted by character
+	charactersPerMinute = 500
+
+	// firstImageSeconds is the time spent on the first image. Every following
+	// image takes a second less, down to minImageSeconds
+	firstImageSeconds = 12
+	minImageSeconds   = 3
+
+	// ExcerptLength is the maximum length of an excerpt in characters
+	ExcerptLength = 200
+)
+
+// Summary describes the length of a document
+type Summary struct {
+	WordCount int
+	// ReadingTime is the estimated reading time in whole minutes, at least one
+	ReadingTime int
+	Excerpt     string
+}
+
+// Summarize computes the summary of a block document
+func Summarize(doc *blocks.Document) *Summary {
+	text := blocks.PlainText(doc)
+	words, characters := countWords(text)
+
+	seconds := float64(words)*60/wordsPerMinute + float64(characters)*60/charactersPerMinute
+	seconds += float64(imageSeconds(countImages(doc.Blocks)))
+
+	minutes := int(seconds+59) / 60
+	if minutes < 1 {
+		minutes = 1
+	}
+
+	return &Summary{
+		WordCount:   words + characters,
+		ReadingTime: minutes,
+		Excerpt:     Excerpt(doc, ExcerptLength),
+	}
+}
+
+// isCJK reports whether r belongs to a script written without spaces between words.
+// Korean separates words with spaces, so Hangul is counted like latin text
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// countWords counts space delimited words and Chinese and Japanese characters separately
+func countWords(text string) (words int, characters int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			characters++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if !inWord {
+				words++
+			}
+			inWord = true
+		case unicode.IsSpace(r):
+			inWord = false
+		}
+	}
+	return words, characters
+}
+
+func countImages(bs []*blocks.Block) int {
+	count := 0
+	for _, b := range bs {
+		if b.Type == blocks.Image {
+			count++
+		}
+		count += countImages(b.Children)
+	}
+	return count
+}
+
+// imageSeconds returns the time spent looking at the images of a document
+func imageSeconds(images int) int {
+	seconds := 0
+	for i := 0; i < images; i++ {
+		if firstImageSeconds-i > minImageSeconds {
+			seconds += firstImageSeconds - i
+		} else {
+			seconds += minImageSeconds
+		}
+	}
+	return seconds
+}
+
+// Excerpt returns the leading sentences of the paragraphs of a document that fit in limit characters.
+// A first sentence longer than the limit is cut at a word boundary and ends with an ellipsis
+func Excerpt(doc *blocks.Document, limit int) string {
+	var excerpt strings.Builder
+	length := 0
+	spaced := false
+	for _, sentence := range sentences(paragraphs(doc.Blocks)) {
+		n := utf8.RuneCountInString(sentence)
+		if spaced {
+			n++
+		}
+		if length+n > limit {
+			if length == 0 {
+				return truncate(sentence, limit)
+			}
+			break
+		}
+
+		if spaced {
+			excerpt.WriteByte(' ')
+		}
+		excerpt.WriteString(sentence)
+		length += n
+
+		// Sentences ending in full width marks are not followed by spaces
+		last, _ := utf8.DecodeLastRuneInString(sentence)
+		spaced = !isSentenceEnd(last) || last <= unicode.MaxASCII
+	}
+	return excerpt.String()
+}
+
+// paragraphs returns the plain text of paragraphs, including quoted ones
+func paragraphs(bs []*blocks.Block) []string {
+	var texts []string
+	for _, b := range bs {
+		switch b.Type {
+		case blocks.Paragraph:
+			if text := markdown.PlainText(b.Text); text != "" {
+				texts = append(texts, strings.Join(strings.Fields(text), " "))
+			}
+		case blocks.Quote:
+			texts = append(texts, paragraphs(b.Children)...)
+		}
+	}
+	return texts
+}
+
+// isSentenceEnd reports whether r ends a sentence
+func isSentenceEnd(r rune) bool {
+	switch r {
+	case '.', '!', '?', '。', '！', '？':
+		return true
+	}
+	return false
+}
+
+// sentences splits paragraphs into sentences. A sentence ends with a terminal
+// punctuation mark followed by a space, or with the end of the paragraph
+func sentences(paragraphs []string) []string {
+	var result []string
+	for _, p := range paragraphs {
+		runes := []rune(p)
+		start := 0
+		for i, r := range runes {
+			if !isSentenceEnd(r) {
+				continue
+			}
+			// Full width marks are not followed by spaces
+			fullWidth := r > unicode.MaxASCII
+			if i+1 == len(runes) || unicode.IsSpace(runes[i+1]) || fullWidth {
+				if s := strings.TrimSpace(string(runes[start : i+1])); s != "" {
+					result = append(result, s)
+				}
+				start = i + 1
+			}
+		}
+		if s := strings.TrimSpace(string(runes[start:])); s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// truncate cuts s to at most limit characters including the ellipsis,
+// preferring the last space when there is one
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+
+	cut := limit - 1
+	for i := cut; i > limit/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimSpace(string(runes[:cut])) + "…"
+}
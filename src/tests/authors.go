@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func postAuthors(c *Container, postID int) []interface{} {
+	return makeValidReq(c, "GET", fmt.Sprintf("/posts/%d", postID), nil, nil)["authors"].([]interface{})
+}
+
+// testCoAuthors tests /posts/:id/authors to share a post between several authors
+func testCoAuthors(c *Container) {
+	var primaryCookies, firstCookies, secondCookies []*http.Cookie
+	var firstID, secondID, postID int
+
+	c.Goblin.Before(func() {
+		primaryCookies = createTestUserAndLogin(c, "test-byline-primary@test.com", "test-pwd")
+		firstCookies = createTestUserAndLogin(c, "test-byline-first@test.com", "test-pwd")
+		secondCookies = createTestUserAndLogin(c, "test-byline-second@test.com", "test-pwd")
+		firstID = getUserFromDBByEmail(c, "test-byline-first@test.com").ID
+		secondID = getUserFromDBByEmail(c, "test-byline-second@test.com").ID
+		postID = createPostWithAPI(c, Data{"title": "Written together", "doc": "draft"}, primaryCookies)
+	})
+
+	c.Goblin.It("POST /:id/authors should invite co-authors without adding their bylines", func() {
+		path := fmt.Sprintf("/posts/%d/authors", postID)
+		makeValidStatusReq(c, "POST", path, Data{"user_id": firstID}, primaryCookies)
+		makeValidStatusReq(c, "POST", path, Data{"user_id": secondID}, primaryCookies)
+
+		authors := makeValidReq(c, "GET", path, nil, primaryCookies)["authors"].([]interface{})
+		c.Goblin.Assert(len(authors)).Eql(2)
+		c.Goblin.Assert(authors[0].(map[string]interface{})["accepted"]).IsFalse()
+		c.Goblin.Assert(postAuthors(c, postID)).Eql([]interface{}{"Test-Byline-Primary"})
+
+		invitations := makeValidReq(c, "GET", "/users/me/invitations", nil, firstCookies)
+		c.Goblin.Assert(searchResultIDs(invitations)).Eql([]int{postID})
+	})
+
+	c.Goblin.It("POST /:id/authors/accept should add the bylines in order", func() {
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors/accept", postID), nil, secondCookies)
+		c.Goblin.Assert(postAuthors(c, postID)).Eql([]interface{}{"Test-Byline-Primary", "Test-Byline-Second"})
+
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors/accept", postID), nil, firstCookies)
+		c.Goblin.Assert(postAuthors(c, postID)).Eql([]interface{}{"Test-Byline-Primary", "Test-Byline-First", "Test-Byline-Second"})
+	})
+
+	c.Goblin.It("PUT /:id/authors should reorder the co-authors", func() {
+		makeValidStatusReq(c, "PUT", fmt.Sprintf("/posts/%d/authors", postID), Data{"user_ids": []int{secondID, firstID}}, primaryCookies)
+		c.Goblin.Assert(postAuthors(c, postID)).Eql([]interface{}{"Test-Byline-Primary", "Test-Byline-Second", "Test-Byline-First"})
+	})
+
+	c.Goblin.It("GET /posts and /search should list the bylines of co-authors", func() {
+		bylines := []interface{}{"Test-Byline-Primary", "Test-Byline-Second", "Test-Byline-First"}
+		listed := makeValidReq(c, "GET", "/posts?author=test-byline-primary", nil, nil)["posts"].([]interface{})
+		c.Goblin.Assert(listed[0].(map[string]interface{})["authors"]).Eql(bylines)
+
+		found := searchWithAPI(c, "q=together&author=test-byline-primary")["posts"].([]interface{})
+		c.Goblin.Assert(found[0].(map[string]interface{})["authors"]).Eql(bylines)
+	})
+
+	c.Goblin.It("PUT /posts should let accepted co-authors update the post", func() {
+		response := updatePostWithAPI(c, Data{"id": postID, "doc": "revised together"}, firstCookies)
+		c.Goblin.Assert(response["doc"]).Eql("revised together")
+		c.Goblin.Assert(response["author"]).Eql("Test-Byline-Primary")
+		c.Goblin.Assert(len(response["authors"].([]interface{}))).Eql(3)
+	})
+
+	c.Goblin.It("DELETE /:id/authors/:user_id should let a co-author leave", func() {
+		makeValidStatusReq(c, "DELETE", fmt.Sprintf("/posts/%d/authors/%d", postID, secondID), nil, secondCookies)
+		c.Goblin.Assert(postAuthors(c, postID)).Eql([]interface{}{"Test-Byline-Primary", "Test-Byline-First"})
+	})
+
+	testCoAuthorsWithInvalidData(c)
+}
+
+// RunCoAuthorsTests executes all tests for /posts/:id/authors
+func RunCoAuthorsTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/authors", func() {
+		// GET, POST, PUT /posts/:id/authors, POST /posts/:id/authors/accept,
+		// DELETE /posts/:id/authors/:user_id, GET /users/me/invitations
+		testCoAuthors(c)
+	})
+}
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testCoAuthorsWithInvalidData(c *Container) {
+	c.Goblin.It("POST /:id/authors by a co-author should return error", func() {
+		primaryCookies := createTestUserAndLogin(c, "test-byline-host@test.com", "test-pwd")
+		cookies := createTestUserAndLogin(c, "test-byline-guest@test.com", "test-pwd")
+		guestID := getUserFromDBByEmail(c, "test-byline-guest@test.com").ID
+		createTestUser(c, "test-byline-friend@test.com", "test-pwd")
+		friendID := getUserFromDBByEmail(c, "test-byline-friend@test.com").ID
+		postID := createPostWithAPI(c, Data{"doc": "hosted"}, primaryCookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors", postID), Data{"user_id": guestID}, primaryCookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors/accept", postID), nil, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"user_id": friendID},
+			"POST",
+			fmt.Sprintf("/posts/%d/authors", postID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:id/authors with the primary author should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-byline-self@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-byline-self@test.com").ID
+		postID := createPostWithAPI(c, Data{"doc": "mine alone"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"user_id": userID},
+			"POST",
+			fmt.Sprintf("/posts/%d/authors", postID),
+			"User is already an author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:id/authors/accept without an invitation should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-byline-closed@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "closed"}, authorCookies)
+		cookies := createTestUserAndLogin(c, "test-byline-crasher@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/authors/accept", postID),
+			"Invitation not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /posts by a pending co-author should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-byline-inviter@test.com", "test-pwd")
+		cookies := createTestUserAndLogin(c, "test-byline-pending@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-byline-pending@test.com").ID
+		postID := createPostWithAPI(c, Data{"doc": "not yet"}, authorCookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors", postID), Data{"user_id": userID}, authorCookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"id": postID, "doc": "too early"},
+			"PUT",
+			"/posts",
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("DELETE /posts/:id by a co-author should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-byline-lead@test.com", "test-pwd")
+		cookies := createTestUserAndLogin(c, "test-byline-helper@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-byline-helper@test.com").ID
+		postID := createPostWithAPI(c, Data{"doc": "shared"}, authorCookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors", postID), Data{"user_id": userID}, authorCookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors/accept", postID), nil, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			fmt.Sprintf("/posts/%d", postID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /:id/authors with a missing co-author should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-byline-order@test.com", "test-pwd")
+		createTestUser(c, "test-byline-listed@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-byline-listed@test.com").ID
+		postID := createPostWithAPI(c, Data{"doc": "ordered"}, cookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/authors", postID), Data{"user_id": userID}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"user_ids": []int{}},
+			"PUT",
+			fmt.Sprintf("/posts/%d/authors", postID),
+			"Invalid order.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
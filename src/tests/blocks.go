@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+)
+
+var sampleBlocks = map[string]interface{}{
+	"version": blocks.Version,
+	"blocks": []map[string]interface{}{
+		{"type": "heading", "level": 1, "text": "Title"},
+		{"type": "paragraph", "text": "Some *text*"},
+		{"type": "image", "url": "https://example.com/a.png", "alt": "A picture", "caption": "Caption"},
+		{"type": "quote", "children": []map[string]interface{}{
+			{"type": "paragraph", "text": "Quoted"},
+		}},
+		{"type": "code", "language": "go", "text": "fmt.Println()"},
+		{"type": "embed", "url": "https://example.com/video"},
+	},
+}
+
+// testCreatePostWithBlocks tests creating posts from a block document
+func testCreatePostWithBlocks(c *Container) {
+	c.Goblin.It("POST should store the blocks and their markdown", func() {
+		cookies := createTestUserAndLogin(c, "test-create-blocks@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "blocks", "blocks": sampleBlocks}, cookies)
+
+		post := getPostFromDBByID(c, id)
+		c.Goblin.Assert(post.Document.String).Eql(
+			"# Title\n\nSome *text*\n\n![A picture](<https://example.com/a.png> \"Caption\")\n\n" +
+				"> Quoted\n\n```go\nfmt.Println()\n```\n\n[https://example.com/video](<https://example.com/video>)")
+		c.Goblin.Assert(post.Blocks.Valid).IsTrue()
+	})
+
+	c.Goblin.It("/:id?format=blocks GET should return the block tree", func() {
+		cookies := createTestUserAndLogin(c, "test-get-blocks@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "blocks", "doc": "## Heading\n\nBody"}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d?format=blocks", id),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+
+		doc := response["blocks"].(map[string]interface{})
+		c.Goblin.Assert(doc["version"]).Eql(float64(blocks.Version))
+		c.Goblin.Assert(doc["blocks"]).Eql([]interface{}{
+			map[string]interface{}{"type": "heading", "level": float64(2), "text": "Heading"},
+			map[string]interface{}{"type": "paragraph", "text": "Body"},
+		})
+	})
+
+	testCreatePostWithInvalidBlocks(c)
+}
+
+// testConvertBlocks tests conversion between blocks, markdown and plain text
+func testConvertBlocks(c *Container) {
+	c.Goblin.It("FromMarkdown should read back the markdown written by ToMarkdown", func() {
+		doc := blocks.FromMarkdown("# Title\n\n> Quote\n>\n> ````\n> ```\n> ````\n\n![alt](https://example.com/a.png)\n\n- a list\n- kept as text\n")
+		c.Goblin.Assert(blocks.Validate(doc)).IsNil()
+		c.Goblin.Assert(blocks.FromMarkdown(blocks.ToMarkdown(doc))).Eql(doc)
+		c.Goblin.Assert(doc.Blocks[len(doc.Blocks)-1]).Eql(&blocks.Block{Type: blocks.Paragraph, Text: "- a list\n- kept as text"})
+	})
+
+	c.Goblin.It("FromMarkdown should read back embeds and images of uploads", func() {
+		doc := &blocks.Document{Version: blocks.Version, Blocks: []*blocks.Block{
+			{Type: blocks.Embed, URL: "/api/v1/uploads/abc.png"},
+			{Type: blocks.Embed, URL: "https://example.com/watch?v=1&t=<2>", Caption: `A "video"`},
+			{Type: blocks.Image, URL: "/api/v1/uploads/a b.png", Alt: "[alt] *text*", Caption: `back\slash`},
+		}}
+		c.Goblin.Assert(blocks.Validate(doc)).IsNil()
+		c.Goblin.Assert(blocks.FromMarkdown(blocks.ToMarkdown(doc))).Eql(doc)
+	})
+
+	c.Goblin.It("Validate should accept web URLs and root-relative paths only", func() {
+		image := func(url string) *blocks.Document {
+			return &blocks.Document{Version: blocks.Version, Blocks: []*blocks.Block{{Type: blocks.Image, URL: url}}}
+		}
+		c.Goblin.Assert(blocks.Validate(image("https://example.com/a.png"))).IsNil()
+		c.Goblin.Assert(blocks.Validate(image("/api/v1/uploads/a.png"))).IsNil()
+		for _, url := range []string{"javascript:alert(1)", "data:image/png;base64,AAAA", "//example.com/a.png", "/\\example.com/a.png", "a.png"} {
+			c.Goblin.Assert(blocks.Validate(image(url))).IsNotNil()
+		}
+	})
+
+	c.Goblin.It("PlainText should strip markup", func() {
+		doc := &blocks.Document{Version: blocks.Version, Blocks: []*blocks.Block{
+			{Type: blocks.Heading, Level: 2, Text: "A **bold** title"},
+			{Type: blocks.Quote, Children: []*blocks.Block{
+				{Type: blocks.Paragraph, Text: "[A link](https://example.com)"},
+			}},
+			{Type: blocks.Image, URL: "https://example.com/a.png", Alt: "Alt text"},
+		}}
+		c.Goblin.Assert(blocks.PlainText(doc)).Eql("A bold title\nA link\nAlt text")
+	})
+}
+
+// RunBlocksTests executes all tests for block documents
+func RunBlocksTests(c *Container) {
+	c.Goblin.Describe("API /posts blocks", func() {
+		// POST /posts with blocks, GET /posts/:id?format=blocks
+		testCreatePostWithBlocks(c)
+
+		// blocks conversions
+		testConvertBlocks(c)
+	})
+}
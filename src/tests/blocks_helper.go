@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"net/http"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+)
+
+func testCreatePostWithInvalidBlocks(c *Container) {
+	c.Goblin.It("POST with an unknown block type should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-create-blocks-unknown@test.com", "test-pwd")
+		values := Data{
+			"blocks": map[string]interface{}{
+				"version": blocks.Version,
+				"blocks":  []map[string]interface{}{{"type": "video", "url": "https://example.com"}},
+			},
+		}
+
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"POST",
+			"/posts",
+			`Invalid blocks: blocks[0].type: unknown block type "video"`,
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with an unsupported version should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-create-blocks-version@test.com", "test-pwd")
+		values := Data{
+			"blocks": map[string]interface{}{
+				"version": 99,
+				"blocks":  []map[string]interface{}{{"type": "paragraph", "text": "text"}},
+			},
+		}
+
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"POST",
+			"/posts",
+			"Invalid blocks: version: unsupported version 99",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with a paragraph holding other blocks should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-create-blocks-injected@test.com", "test-pwd")
+		values := Data{
+			"blocks": map[string]interface{}{
+				"version": blocks.Version,
+				"blocks":  []map[string]interface{}{{"type": "paragraph", "text": "Intro\n\n# Injected"}},
+			},
+		}
+
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"POST",
+			"/posts",
+			"Invalid blocks: blocks[0].text: must be a single paragraph",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
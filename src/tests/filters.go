@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"fmt"
+	"time"
+)
+
+func listPostIDs(c *Container, query string) []int {
+	response := makeValidReq(c, "GET", "/posts?"+query, nil, nil)
+	return searchResultIDs(response)
+}
+
+// testFilterPosts tests the filters of GET /posts
+func testFilterPosts(c *Container) {
+	c.Goblin.It("?tags_any and ?exclude_tags GET should match any tag and leave out excluded ones", func() {
+		cookies := createTestUserAndLogin(c, "test-filter-tags@test.com", "test-pwd")
+		appleID := createPostWithAPI(c, Data{"doc": "text", "tags": "filter-apple"}, cookies)
+		pearID := createPostWithAPI(c, Data{"doc": "text", "tags": "filter-pear,filter-rotten"}, cookies)
+		bothID := createPostWithAPI(c, Data{"doc": "text", "tags": "filter-apple,filter-pear"}, cookies)
+
+		c.Goblin.Assert(listPostIDs(c, "tags_any=filter-apple,filter-pear")).Eql([]int{bothID, pearID, appleID})
+		c.Goblin.Assert(listPostIDs(c, "tags=filter-apple,filter-pear")).Eql([]int{bothID})
+		c.Goblin.Assert(listPostIDs(c, "tags_any=Filter-Pear&exclude_tags=filter-rotten")).Eql([]int{bothID})
+	})
+
+	c.Goblin.It("?author GET should match any of several authors", func() {
+		firstCookies := createTestUserAndLogin(c, "filter-author-one@test.com", "test-pwd")
+		secondCookies := createTestUserAndLogin(c, "filter-author-two@test.com", "test-pwd")
+		firstID := createPostWithAPI(c, Data{"doc": "text"}, firstCookies)
+		secondID := createPostWithAPI(c, Data{"doc": "text"}, secondCookies)
+
+		c.Goblin.Assert(listPostIDs(c, "author=filter-author-one,Filter-Author-Two")).Eql([]int{secondID, firstID})
+		c.Goblin.Assert(listPostIDs(c, "author=filter-author-one&author=filter-author-two")).Eql([]int{secondID, firstID})
+	})
+
+	c.Goblin.It("?published_after, ?created_before and ?min_likes GET should narrow down posts", func() {
+		cookies := createTestUserAndLogin(c, "filter-range@test.com", "test-pwd")
+		popularID := createPostWithAPI(c, Data{"doc": "text"}, cookies)
+		createPostWithAPI(c, Data{"doc": "text"}, cookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", popularID), nil, cookies)
+
+		now := time.Now().UTC()
+		hourAgo := now.Add(-time.Hour).Format(time.RFC3339)
+		hourLater := now.Add(time.Hour).Format(time.RFC3339)
+
+		c.Goblin.Assert(listPostIDs(c, "author=filter-range&min_likes=1")).Eql([]int{popularID})
+		c.Goblin.Assert(len(listPostIDs(c, "author=filter-range&published_after="+hourAgo+"&created_before="+hourLater))).Eql(2)
+		c.Goblin.Assert(len(listPostIDs(c, "author=filter-range&published_after="+hourLater))).Eql(0)
+	})
+
+	c.Goblin.It("?status GET should list the caller's own drafts and scheduled posts", func() {
+		cookies := createTestUserAndLogin(c, "filter-status@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"doc": "text"}, cookies)
+		publishAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		scheduledID := createPostWithAPI(c, Data{"doc": "text", "publish_at": publishAt}, cookies)
+
+		response := makeValidReq(c, "GET", "/posts?status=scheduled", nil, cookies)
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{scheduledID})
+
+		response = makeValidReq(c, "GET", "/posts?status=draft", nil, cookies)
+		c.Goblin.Assert(response["total_count"]).Eql(float64(0))
+	})
+
+	testFilterPostsWithInvalidFilters(c)
+}
+
+// RunFiltersTests executes all tests for the filters of GET /posts
+func RunFiltersTests(c *Container) {
+	c.Goblin.Describe("API /posts filters", func() {
+		// GET /posts with filters
+		testFilterPosts(c)
+	})
+}
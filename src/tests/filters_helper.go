@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"net/http"
+)
+
+func testFilterPostsWithInvalidFilters(c *Container) {
+	c.Goblin.It("GET with unknown or malformed filters should return error for each field", func() {
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    "/posts?colour=red&min_likes=-1&published_after=yesterday&status=draft",
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusBadRequest)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["message"]).Eql("Invalid filters.")
+		c.Goblin.Assert(response["fields"]).Eql(map[string]interface{}{
+			"colour":          "Unknown filter.",
+			"min_likes":       "Must be a non-negative integer.",
+			"published_after": "Must be an RFC3339 time or a date (YYYY-MM-DD).",
+			"status":          "Login required to list unpublished posts.",
+		})
+	})
+
+	c.Goblin.It("GET with an empty date range should return error", func() {
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    "/posts?created_after=2021-05-02&created_before=2021-05-01",
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusBadRequest)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["fields"]).Eql(map[string]interface{}{
+			"created_before": "Must be after created_after.",
+		})
+	})
+}
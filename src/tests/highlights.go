@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/anchor"
+)
+
+const highlightedDoc = "The quick brown fox jumps over the lazy dog. Pack my box with five dozen liquor jugs."
+
+func createHighlightWithAPI(c *Container, postID int, values Data, cookies []*http.Cookie) map[string]interface{} {
+	return makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/highlights", postID), values, cookies)
+}
+
+func getHighlights(c *Container, postID int, cookies []*http.Cookie) map[string]interface{} {
+	return makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/highlights", postID), nil, cookies)
+}
+
+func ownHighlight(c *Container, postID int, cookies []*http.Cookie) map[string]interface{} {
+	mine := getHighlights(c, postID, cookies)["mine"].([]interface{})
+	c.Goblin.Assert(len(mine)).Eql(1)
+	return mine[0].(map[string]interface{})
+}
+
+// testHighlights tests /posts/:id/highlights to highlight passages of posts
+func testHighlights(c *Container) {
+	c.Goblin.It("POST should anchor the highlight at the quoted passage", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+
+		highlight := createHighlightWithAPI(c, postID, Data{"start": 4, "end": 19, "quote": "quick brown fox"}, cookies)
+		c.Goblin.Assert(highlight["start"]).Eql(float64(4))
+		c.Goblin.Assert(highlight["end"]).Eql(float64(19))
+		c.Goblin.Assert(highlight["located"]).IsTrue()
+		c.Goblin.Assert(highlight["note"]).IsNil()
+	})
+
+	c.Goblin.It("GET should rank passages by readers and keep notes private", func() {
+		authorCookies := createTestUserAndLogin(c, "test-highlight-top@test.com", "test-pwd")
+		readerCookies := createTestUserAndLogin(c, "test-highlight-reader@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, authorCookies)
+
+		createHighlightWithAPI(c, postID, Data{"quote": "lazy dog"}, authorCookies)
+		createHighlightWithAPI(c, postID, Data{"quote": "Pack my box", "note": "pangram"}, authorCookies)
+		createHighlightWithAPI(c, postID, Data{"quote": "Pack my box"}, readerCookies)
+
+		response := getHighlights(c, postID, nil)
+		_, hasMine := response["mine"]
+		c.Goblin.Assert(hasMine).IsFalse()
+		top := response["top"].([]interface{})
+		c.Goblin.Assert(len(top)).Eql(2)
+		c.Goblin.Assert(top[0].(map[string]interface{})["quote"]).Eql("Pack my box")
+		c.Goblin.Assert(top[0].(map[string]interface{})["count"]).Eql(float64(2))
+
+		mine := getHighlights(c, postID, authorCookies)["mine"].([]interface{})
+		c.Goblin.Assert(len(mine)).Eql(2)
+		c.Goblin.Assert(mine[0].(map[string]interface{})["quote"]).Eql("lazy dog")
+		c.Goblin.Assert(mine[1].(map[string]interface{})["note"]).Eql("pangram")
+	})
+
+	c.Goblin.It("GET should re-anchor highlights after the post is edited", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-edit@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+		createHighlightWithAPI(c, postID, Data{"start": 4, "end": 25, "quote": "quick brown fox jumps"}, cookies)
+
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Intro sentence added. The quick brown fax jumps over the lazy dog."}, cookies)
+		highlight := ownHighlight(c, postID, cookies)
+		c.Goblin.Assert(highlight["located"]).IsTrue()
+		c.Goblin.Assert(highlight["start"]).Eql(float64(26))
+		c.Goblin.Assert(highlight["end"]).Eql(float64(47))
+
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Nothing of the original text remains."}, cookies)
+		highlight = ownHighlight(c, postID, cookies)
+		c.Goblin.Assert(highlight["located"]).IsFalse()
+		c.Goblin.Assert(highlight["start"]).IsNil()
+		c.Goblin.Assert(highlight["quote"]).Eql("quick brown fox jumps")
+	})
+
+	c.Goblin.It("GET should only return passages of the preview of a members-only post to anonymous readers", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-paywall@test.com", "test-pwd")
+		postID := createMembersOnlyPost(c, "Highlighted members only", cookies)
+		createHighlightWithAPI(c, postID, Data{"quote": "First paragraph"}, cookies)
+		createHighlightWithAPI(c, postID, Data{"quote": "Fifth paragraph"}, cookies)
+
+		response := getHighlights(c, postID, nil)
+		c.Goblin.Assert(response["paywall"]).IsTrue()
+		top := response["top"].([]interface{})
+		c.Goblin.Assert(len(top)).Eql(1)
+		c.Goblin.Assert(top[0].(map[string]interface{})["quote"]).Eql("First paragraph")
+
+		response = getHighlights(c, postID, cookies)
+		c.Goblin.Assert(response["paywall"]).IsFalse()
+		c.Goblin.Assert(len(response["top"].([]interface{}))).Eql(2)
+	})
+
+	c.Goblin.It("anchor.Locate should not search for edited quotes beyond anchor.MaxComparisons", func() {
+		quote := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 3)
+		edited := strings.Replace(quote, "fox", "fax", 1)
+
+		r, ok := anchor.Locate("Intro. "+edited, quote, 0)
+		c.Goblin.Assert(ok).IsTrue()
+		c.Goblin.Assert(r.Start).Eql(7)
+
+		filler := strings.Repeat("x", anchor.MaxComparisons/len(quote)+1)
+		_, ok = anchor.Locate(filler+edited, quote, 0)
+		c.Goblin.Assert(ok).IsFalse()
+		r, ok = anchor.Locate(filler+quote, quote, 0)
+		c.Goblin.Assert(ok).IsTrue()
+		c.Goblin.Assert(r.Start).Eql(len(filler))
+	})
+
+	c.Goblin.It("PUT and DELETE should change the highlights of the user", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-change@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+		highlight := createHighlightWithAPI(c, postID, Data{"quote": "five dozen"}, cookies)
+		path := fmt.Sprintf("/posts/%d/highlights/%d", postID, int(highlight["id"].(float64)))
+
+		makeValidStatusReq(c, "PUT", path, Data{"note": "count them"}, cookies)
+		c.Goblin.Assert(ownHighlight(c, postID, cookies)["note"]).Eql("count them")
+
+		makeValidStatusReq(c, "DELETE", path, nil, cookies)
+		c.Goblin.Assert(len(getHighlights(c, postID, cookies)["mine"].([]interface{}))).Eql(0)
+	})
+
+	testHighlightsWithInvalidData(c)
+}
+
+// RunHighlightsTests executes all tests for /posts/:id/highlights
+func RunHighlightsTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/highlights", func() {
+		// GET, POST /posts/:id/highlights, PUT, DELETE /posts/:id/highlights/:highlight_id
+		testHighlights(c)
+	})
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/anchor"
+)
+
+func testHighlightsWithInvalidData(c *Container) {
+	c.Goblin.It("POST without a quote should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-no-quote@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"start": 0, "end": 3},
+			"POST",
+			fmt.Sprintf("/posts/%d/highlights", postID),
+			"Quote required.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with a quote that is too long should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-long-quote@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"quote": strings.Repeat("a", anchor.MaxQuoteLength+1)},
+			"POST",
+			fmt.Sprintf("/posts/%d/highlights", postID),
+			fmt.Sprintf("Quote must be at most %d characters.", anchor.MaxQuoteLength),
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with a quote that is not in the post should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-highlight-bad-quote@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"quote": "a sentence from another post entirely"},
+			"POST",
+			fmt.Sprintf("/posts/%d/highlights", postID),
+			"Quote not found in the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST on a members-only post the user cannot read should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-highlight-paid@test.com", "test-pwd")
+		cookies := createTestUserAndLogin(c, "test-highlight-unpaid@test.com", "test-pwd")
+		for i := 0; i < c.Env.FreeArticlesPerMonth; i++ {
+			readPost(c, createMembersOnlyPost(c, fmt.Sprintf("Metered highlight %d", i), authorCookies), cookies)
+		}
+		postID := createMembersOnlyPost(c, "Highlighted members only", authorCookies)
+
+		for _, quote := range []string{"Fifth paragraph", "a sentence from another post entirely"} {
+			c.makeInvalidReq(&errorTestCase{
+				Data{"quote": quote},
+				"POST",
+				fmt.Sprintf("/posts/%d/highlights", postID),
+				"Subscription required.",
+				http.StatusPaymentRequired,
+				cookies,
+			})
+		}
+	})
+
+	c.Goblin.It("DELETE a highlight of another user should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-highlight-owner@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "Highlights", "doc": highlightedDoc}, ownerCookies)
+		highlight := createHighlightWithAPI(c, postID, Data{"quote": "lazy dog"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-highlight-intruder@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			fmt.Sprintf("/posts/%d/highlights/%d", postID, int(highlight["id"].(float64))),
+			"Highlight not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
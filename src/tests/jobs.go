@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+)
+
+// testRunner tests the runner of background jobs
+func testRunner(c *Container) {
+	c.Goblin.It("Start should run every job once right away", func() {
+		startedAt := time.Date(2100, time.May, 1, 9, 0, 0, 0, time.UTC)
+		ran := make(chan time.Time, 1)
+		runner := jobs.NewRunner(logrus.New(), func() time.Time { return startedAt })
+		runner.Add(&jobs.Job{
+			Name:     "test-start",
+			Interval: time.Hour,
+			Run: func(ctx context.Context, now time.Time) error {
+				ran <- now
+				return nil
+			},
+		})
+
+		ctx, cancel := context.WithCancel(c.Context)
+		defer cancel()
+		runner.Start(ctx)
+		select {
+		case now := <-ran:
+			c.Goblin.Assert(now).Eql(startedAt)
+		case <-time.After(5 * time.Second):
+			c.Goblin.Fail("job did not run at start")
+		}
+	})
+}
+
+// RunJobsTests executes all tests for background jobs
+func RunJobsTests(c *Container) {
+	c.Goblin.Describe("Background jobs", func() {
+		testRunner(c)
+	})
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func createListWithAPI(c *Container, values Data, cookies []*http.Cookie) int {
+	response := makeValidReq(c, "POST", "/lists", values, cookies)
+	return int(response["id"].(float64))
+}
+
+func userLists(c *Container, userID int, cookies []*http.Cookie) []interface{} {
+	response := makeValidReq(c, "GET", fmt.Sprintf("/users/%d/lists", userID), nil, cookies)
+	return response["lists"].([]interface{})
+}
+
+// testBookmarkPost tests /posts/:id/bookmark to save posts to the default list
+func testBookmarkPost(c *Container) {
+	c.Goblin.It("POST should save the post to the default list", func() {
+		cookies := createTestUserAndLogin(c, "test-bookmark@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "test-bookmark@test.com")
+		id := createPostWithAPI(c, Data{"title": "Bookmarks", "doc": "save me"}, cookies)
+
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/posts/%d/bookmark", id), nil, cookies)
+
+		lists := userLists(c, user.ID, cookies)
+		c.Goblin.Assert(len(lists)).Eql(1)
+		saved := lists[0].(map[string]interface{})
+		c.Goblin.Assert(saved["name"]).Eql("Saved")
+		c.Goblin.Assert(saved["default"]).IsTrue()
+		c.Goblin.Assert(saved["post_count"]).Eql(float64(1))
+	})
+
+	c.Goblin.It("GET /posts/:id should tell whether the caller bookmarked the post", func() {
+		cookies := createTestUserAndLogin(c, "test-bookmark-indicator@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "Bookmarks", "doc": "indicator"}, cookies)
+		path := fmt.Sprintf("/posts/%d", id)
+
+		c.Goblin.Assert(makeValidReq(c, "GET", path, nil, cookies)["bookmarked"]).IsFalse()
+
+		makeValidStatusReq(c, "POST", path+"/bookmark", nil, cookies)
+		c.Goblin.Assert(makeValidReq(c, "GET", path, nil, cookies)["bookmarked"]).IsTrue()
+		_, marked := makeValidReq(c, "GET", path, nil, nil)["bookmarked"]
+		c.Goblin.Assert(marked).IsFalse()
+
+		makeValidStatusReq(c, "DELETE", path+"/bookmark", nil, cookies)
+		c.Goblin.Assert(makeValidReq(c, "GET", path, nil, cookies)["bookmarked"]).IsFalse()
+	})
+}
+
+// testReadingLists tests /lists to manage named reading lists
+func testReadingLists(c *Container) {
+	c.Goblin.It("GET /users/:id/lists should only show public lists to others", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-visibility@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "test-lists-visibility@test.com")
+		createListWithAPI(c, Data{"name": "Private reads"}, cookies)
+		createListWithAPI(c, Data{"name": "Public reads", "public": true}, cookies)
+
+		c.Goblin.Assert(len(userLists(c, user.ID, cookies))).Eql(3)
+
+		lists := userLists(c, user.ID, nil)
+		c.Goblin.Assert(len(lists)).Eql(1)
+		c.Goblin.Assert(lists[0].(map[string]interface{})["name"]).Eql("Public reads")
+	})
+
+	c.Goblin.It("POST /:id/posts should append posts which PUT can reorder", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-order@test.com", "test-pwd")
+		listID := createListWithAPI(c, Data{"name": "Ordered", "public": true}, cookies)
+		path := fmt.Sprintf("/lists/%d", listID)
+
+		first := createPostWithAPI(c, Data{"title": "First", "doc": "one"}, cookies)
+		second := createPostWithAPI(c, Data{"title": "Second", "doc": "two"}, cookies)
+		makeValidStatusReq(c, "POST", path+"/posts", Data{"post_id": first}, cookies)
+		makeValidStatusReq(c, "POST", path+"/posts", Data{"post_id": second}, cookies)
+		makeValidStatusReq(c, "POST", path+"/posts", Data{"post_id": first}, cookies)
+		c.Goblin.Assert(searchResultIDs(makeValidReq(c, "GET", path, nil, nil))).Eql([]int{first, second})
+
+		makeValidStatusReq(c, "PUT", path+"/posts", Data{"post_ids": []int{second, first}}, cookies)
+		c.Goblin.Assert(searchResultIDs(makeValidReq(c, "GET", path, nil, nil))).Eql([]int{second, first})
+
+		makeValidStatusReq(c, "DELETE", fmt.Sprintf("%s/posts/%d", path, second), nil, cookies)
+		c.Goblin.Assert(searchResultIDs(makeValidReq(c, "GET", path, nil, nil))).Eql([]int{first})
+	})
+
+	c.Goblin.It("DELETE /:id should delete a custom list", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-delete@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "test-lists-delete@test.com")
+		listID := createListWithAPI(c, Data{"name": "Temporary"}, cookies)
+
+		makeValidStatusReq(c, "DELETE", fmt.Sprintf("/lists/%d", listID), nil, cookies)
+		c.Goblin.Assert(len(userLists(c, user.ID, cookies))).Eql(1)
+	})
+
+	testReadingListsWithInvalidData(c)
+}
+
+// RunListsTests executes all tests for reading lists and bookmarks
+func RunListsTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/bookmark", func() {
+		// POST, DELETE /posts/:id/bookmark
+		testBookmarkPost(c)
+	})
+
+	c.Goblin.Describe("API /lists", func() {
+		// POST /lists, GET /users/:id/lists, GET, DELETE /lists/:id, POST, PUT, DELETE /lists/:id/posts
+		testReadingLists(c)
+	})
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testReadingListsWithInvalidData(c *Container) {
+	c.Goblin.It("POST with a name already in use should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-duplicate@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"name": "Saved"},
+			"POST",
+			"/lists",
+			"List already exists.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("GET a private list of another user should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-private@test.com", "test-pwd")
+		listID := createListWithAPI(c, Data{"name": "Secret"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/lists/%d", listID),
+			"List not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("POST /:id/posts to a list of another user should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-lists-owner@test.com", "test-pwd")
+		listID := createListWithAPI(c, Data{"name": "Mine"}, ownerCookies)
+		postID := createPostWithAPI(c, Data{"title": "Lists", "doc": "not yours"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-lists-intruder@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			fmt.Sprintf("/lists/%d/posts", listID),
+			"User is not the owner of the list.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /:id/posts missing a post of the list should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-bad-order@test.com", "test-pwd")
+		listID := createListWithAPI(c, Data{"name": "Unordered"}, cookies)
+		postID := createPostWithAPI(c, Data{"title": "Lists", "doc": "order"}, cookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/lists/%d/posts", listID), Data{"post_id": postID}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_ids": []int{}},
+			"PUT",
+			fmt.Sprintf("/lists/%d/posts", listID),
+			"Invalid order.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /posts/:id/bookmark on a draft of another user should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-lists-draft-author@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "not yet", "publish_at": "2100-04-01T09:00:00Z"}, authorCookies)
+		cookies := createTestUserAndLogin(c, "test-lists-draft-reader@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/bookmark", postID),
+			"Post not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("DELETE /:id of the default list should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-lists-default@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "test-lists-default@test.com")
+		saved := userLists(c, user.ID, cookies)[0].(map[string]interface{})
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			fmt.Sprintf("/lists/%d", int(saved["id"].(float64))),
+			"The default list cannot be deleted.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/markdown"
+)
+
+const sampleMarkdown = "# Title\n\n## 소개\n\nSome *text*[^1] <script>alert(1)</script>\n\n" +
+	"| a | b |\n|---|---|\n| 1 | 2 |\n\n[^1]: A footnote\n"
+
+// testGetPostAsHTML tests /posts/:id?format=html to retrieve rendered posts
+func testGetPostAsHTML(c *Container) {
+	c.Goblin.It("/:id?format=html GET should return sanitized HTML and a table of contents", func() {
+		u := userInfo{"test-get-post-html@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, &db.Post{Doc: sampleMarkdown}, &u)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d?format=html", post.ID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+
+		_, docExists := response["doc"]
+		c.Goblin.Assert(docExists).IsFalse()
+
+		html := response["html"].(string)
+		c.Goblin.Assert(strings.Contains(html, "<em>text</em>")).IsTrue()
+		c.Goblin.Assert(strings.Contains(html, "<table>")).IsTrue()
+		c.Goblin.Assert(strings.Contains(html, "<script>")).IsFalse()
+
+		toc := response["toc"].([]interface{})
+		c.Goblin.Assert(len(toc)).Eql(2)
+		second := toc[1].(map[string]interface{})
+		c.Goblin.Assert(second["text"]).Eql("소개")
+		c.Goblin.Assert(second["level"]).Eql(float64(2))
+	})
+
+	c.Goblin.It("/:id?format=both GET should return markdown and HTML", func() {
+		u := userInfo{"test-get-post-both@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, &db.Post{Doc: sampleMarkdown}, &u)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d?format=both", post.ID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["doc"]).Eql(sampleMarkdown)
+		c.Goblin.Assert(response["html"]).IsNotNil()
+	})
+
+	testGetPostWithInvalidFormat(c)
+}
+
+// testRenderMarkdown tests rendering and sanitizing of markdown documents
+func testRenderMarkdown(c *Container) {
+	c.Goblin.It("Render should drop unsafe URLs and keep safe links", func() {
+		rendered := markdown.Render("[bad](javascript:alert(1)) [good](https://example.com)")
+		c.Goblin.Assert(strings.Contains(rendered.HTML, "javascript:")).IsFalse()
+		c.Goblin.Assert(strings.Contains(rendered.HTML, `href="https://example.com"`)).IsTrue()
+	})
+
+	c.Goblin.It("Render should keep fenced code languages and footnotes", func() {
+		rendered := markdown.Render("```go\nfmt.Println()\n```\n\nText[^n]\n\n[^n]: Note\n")
+		c.Goblin.Assert(strings.Contains(rendered.HTML, `class="language-go"`)).IsTrue()
+		c.Goblin.Assert(strings.Contains(rendered.HTML, `class="footnotes"`)).IsTrue()
+	})
+
+	c.Goblin.It("Render should give every heading a unique anchor", func() {
+		rendered := markdown.Render("# Intro\n\n# Intro\n\n## 안녕 하세요\n")
+		c.Goblin.Assert(rendered.TOC).Eql([]markdown.Heading{
+			{Level: 1, Text: "Intro", ID: "intro"},
+			{Level: 1, Text: "Intro", ID: "intro-1"},
+			{Level: 2, Text: "안녕 하세요", ID: "안녕-하세요"},
+		})
+	})
+}
+
+// RunMarkdownTests executes all tests for rendered documents
+func RunMarkdownTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id?format", func() {
+		// GET /posts/:id?format=html
+		testGetPostAsHTML(c)
+
+		// markdown.Render
+		testRenderMarkdown(c)
+	})
+}
@@ -0,0 +1,24 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+func testGetPostWithInvalidFormat(c *Container) {
+	c.Goblin.It("/:id?format=pdf GET should return error", func() {
+		u := userInfo{"test-get-post-format@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, &db.Post{Doc: "# Title"}, &u)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d?format=pdf", post.ID),
+			"Invalid format.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
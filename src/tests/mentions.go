@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+)
+
+// renderedPost returns the HTML of a post
+func renderedPost(c *Container, postID int, cookies []*http.Cookie) string {
+	return makeValidReq(c, "GET", fmt.Sprintf("/posts/%d?format=html", postID), nil, cookies)["html"].(string)
+}
+
+// mentionLink returns the link a mention of a user is rendered as
+func mentionLink(c *Container, email string) string {
+	id := getUserFromDBByEmail(c, email).ID
+	return fmt.Sprintf(`<a href="/api/v1/users/%d" class="mention" rel="nofollow">@%s</a>`, id, strings.Split(email, "@")[0])
+}
+
+// testMentions tests @mentions in posts and share link comments
+func testMentions(c *Container) {
+	var writerCookies, aliceCookies, bobCookies []*http.Cookie
+
+	c.Goblin.Before(func() {
+		writerCookies = createTestUserAndLogin(c, "mention-writer@test.com", "test-pwd")
+		aliceCookies = createTestUserAndLogin(c, "mention-alice@test.com", "test-pwd")
+		bobCookies = createTestUserAndLogin(c, "mention-bob@test.com", "test-pwd")
+	})
+
+	c.Goblin.It("POST /posts should link mentions and notify the mentioned users", func() {
+		postID := createPostWithAPI(c, Data{"title": "Thanks", "doc": "Thanks @Mention-Alice and @nobody-here, not `@mention-bob`."}, writerCookies)
+
+		html := renderedPost(c, postID, nil)
+		c.Goblin.Assert(strings.Contains(html, strings.Replace(mentionLink(c, "mention-alice@test.com"), "@mention-alice", "@Mention-Alice", 1))).IsTrue()
+		c.Goblin.Assert(strings.Contains(html, "@nobody-here")).IsTrue()
+		c.Goblin.Assert(strings.Contains(html, "<code>@mention-bob</code>")).IsTrue()
+
+		_, notifications := getNotifications(c, aliceCookies)
+		mention := findNotification(notifications, "mention")
+		c.Goblin.Assert(mention["message"]).Eql("Mention-Writer mentioned you in a post")
+		c.Goblin.Assert(mention["post_id"]).Eql(float64(postID))
+		c.Goblin.Assert(mention["body"]).Eql("Thanks")
+
+		_, notifications = getNotifications(c, bobCookies)
+		c.Goblin.Assert(findNotification(notifications, "mention")).IsNil()
+	})
+
+	c.Goblin.It("PUT /posts should notify a mentioned user once", func() {
+		postID := createPostWithAPI(c, Data{"doc": "Hello @mention-bob"}, writerCookies)
+		makeValidReq(c, "POST", "/notifications/all/read", nil, bobCookies)
+
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Hello again @mention-bob"}, writerCookies)
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Hello nobody"}, writerCookies)
+		c.Goblin.Assert(strings.Contains(renderedPost(c, postID, nil), "mention")).IsFalse()
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Hello once more @mention-bob"}, writerCookies)
+
+		c.Goblin.Assert(strings.Contains(renderedPost(c, postID, nil), mentionLink(c, "mention-bob@test.com"))).IsTrue()
+		unread, _ := getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(0)
+	})
+
+	c.Goblin.It("publishing a scheduled post should notify the users mentioned in it", func() {
+		makeValidReq(c, "POST", "/notifications/all/read", nil, aliceCookies)
+		draftID := createPostWithAPI(c, Data{"title": "Soon", "doc": "Coming up with @mention-alice", "publish_at": "2100-05-01T09:00:00Z"}, writerCookies)
+		unread, _ := getNotifications(c, aliceCookies)
+		c.Goblin.Assert(unread).Eql(0)
+
+		job := jobs.PublishScheduledPosts(c.DB)
+		c.Goblin.Assert(job.Run(c.Context, time.Date(2100, 5, 1, 9, 0, 0, 0, time.UTC))).IsNil()
+		unread, notifications := getNotifications(c, aliceCookies)
+		c.Goblin.Assert(unread).Eql(1)
+		c.Goblin.Assert(notifications[0]["type"]).Eql("mention")
+		c.Goblin.Assert(notifications[0]["post_id"]).Eql(float64(draftID))
+	})
+
+	c.Goblin.It("POST /shared/:token/comments should only resolve mentions of the authors", func() {
+		draftID := createPostWithAPI(c, Data{"doc": "Have a look", "publish_at": "2100-06-01T09:00:00Z"}, writerCookies)
+		_, token := createShareLinkWithAPI(c, draftID, Data{"allow_comments": true}, writerCookies)
+		comment := makeValidReq(c, "POST", "/shared/"+token+"/comments", Data{"name": "Reviewer", "body": "@mention-writer ask @mention-alice"}, nil)
+
+		mentions := comment["mentions"].([]interface{})
+		c.Goblin.Assert(len(mentions)).Eql(1)
+		writer := getUserFromDBByEmail(c, "mention-writer@test.com")
+		c.Goblin.Assert(mentions[0].(map[string]interface{})["user_id"]).Eql(float64(writer.ID))
+
+		comments := makeValidReq(c, "GET", "/shared/"+token, nil, nil)["comments"].([]interface{})
+		c.Goblin.Assert(len(comments[0].(map[string]interface{})["mentions"].([]interface{}))).Eql(1)
+	})
+}
+
+// RunMentionsTests executes tests for mentions
+func RunMentionsTests(c *Container) {
+	c.Goblin.Describe("Mentions endpoint test", func() {
+		testMentions(c)
+	})
+}
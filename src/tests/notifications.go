@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+)
+
+func followUserWithAPI(c *Container, email string, cookies []*http.Cookie) {
+	makeValidStatusReq(c, "POST", fmt.Sprintf("/users/%d/follow", getUserFromDBByEmail(c, email).ID), nil, cookies)
+}
+
+// getNotifications returns the first page of notifications of a user
+func getNotifications(c *Container, cookies []*http.Cookie) (int, []map[string]interface{}) {
+	response := makeValidReq(c, "GET", "/notifications", nil, cookies)
+	notifications := []map[string]interface{}{}
+	for _, n := range response["notifications"].([]interface{}) {
+		notifications = append(notifications, n.(map[string]interface{}))
+	}
+	return int(response["unread_count"].(float64)), notifications
+}
+
+// findNotification returns the first notification of a type, or nil
+func findNotification(notifications []map[string]interface{}, typ string) map[string]interface{} {
+	for _, n := range notifications {
+		if n["type"] == typ {
+			return n
+		}
+	}
+	return nil
+}
+
+// testNotifications tests the events notifying users and /notifications
+func testNotifications(c *Container) {
+	var authorCookies, aliceCookies, bobCookies []*http.Cookie
+	var postID int
+
+	c.Goblin.Before(func() {
+		authorCookies = createTestUserAndLogin(c, "notify-writer@test.com", "test-pwd")
+		aliceCookies = createTestUserAndLogin(c, "notify-alice@test.com", "test-pwd")
+		bobCookies = createTestUserAndLogin(c, "notify-bob@test.com", "test-pwd")
+		postID = createPostWithAPI(c, Data{"title": "Notified", "doc": "Like me"}, authorCookies)
+	})
+
+	c.Goblin.It("POST /users/:id/follow should notify the followed user once per follower", func() {
+		followUserWithAPI(c, "notify-writer@test.com", aliceCookies)
+		followUserWithAPI(c, "notify-writer@test.com", bobCookies)
+		followUserWithAPI(c, "notify-writer@test.com", aliceCookies)
+
+		unread, notifications := getNotifications(c, authorCookies)
+		c.Goblin.Assert(unread).Eql(1)
+		follow := findNotification(notifications, "follow")
+		c.Goblin.Assert(follow["message"]).Eql("Notify-Bob and 1 other followed you")
+		c.Goblin.Assert(follow["actor_count"]).Eql(float64(2))
+	})
+
+	c.Goblin.It("POST /posts/:id/like should aggregate the likes of a post", func() {
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, aliceCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, bobCookies)
+		makeValidReq(c, "DELETE", fmt.Sprintf("/posts/%d/like", postID), nil, aliceCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, aliceCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, authorCookies)
+
+		unread, notifications := getNotifications(c, authorCookies)
+		c.Goblin.Assert(unread).Eql(2)
+		c.Goblin.Assert(notifications[0]["type"]).Eql("like")
+		c.Goblin.Assert(notifications[0]["message"]).Eql("Notify-Bob and 1 other liked your post")
+		c.Goblin.Assert(notifications[0]["post_id"]).Eql(float64(postID))
+	})
+
+	c.Goblin.It("GET /notifications should page with a cursor", func() {
+		first := makeValidReq(c, "GET", "/notifications?per_page=1", nil, authorCookies)
+		c.Goblin.Assert(len(first["notifications"].([]interface{}))).Eql(1)
+		cursor := first["next_cursor"].(string)
+		c.Goblin.Assert(cursor == "").IsFalse()
+
+		// Aggregating an event into a notification does not move it across pages
+		daveCookies := createTestUserAndLogin(c, "notify-dave@test.com", "test-pwd")
+		followUserWithAPI(c, "notify-writer@test.com", daveCookies)
+
+		second := makeValidReq(c, "GET", "/notifications?per_page=1&cursor="+cursor, nil, authorCookies)
+		c.Goblin.Assert(second["notifications"].([]interface{})[0].(map[string]interface{})["type"]).Eql("follow")
+		c.Goblin.Assert(second["next_cursor"]).Eql("")
+	})
+
+	c.Goblin.It("POST /notifications/:id/read should start a new notification for later events", func() {
+		_, notifications := getNotifications(c, authorCookies)
+		like := findNotification(notifications, "like")
+		response := makeValidReq(c, "POST", fmt.Sprintf("/notifications/%d/read", int(like["id"].(float64))), nil, authorCookies)
+		c.Goblin.Assert(response["unread_count"]).Eql(float64(1))
+
+		carolCookies := createTestUserAndLogin(c, "notify-carol@test.com", "test-pwd")
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, carolCookies)
+
+		unread, notifications := getNotifications(c, authorCookies)
+		c.Goblin.Assert(unread).Eql(2)
+		c.Goblin.Assert(notifications[0]["message"]).Eql("Notify-Carol liked your post")
+		c.Goblin.Assert(notifications[0]["read"]).IsFalse()
+	})
+
+	c.Goblin.It("POST /notifications/all/read should mark every notification as read", func() {
+		response := makeValidReq(c, "POST", "/notifications/all/read", nil, authorCookies)
+		c.Goblin.Assert(response["unread_count"]).Eql(float64(0))
+
+		_, notifications := getNotifications(c, authorCookies)
+		for _, n := range notifications {
+			c.Goblin.Assert(n["read"]).IsTrue()
+		}
+	})
+
+	c.Goblin.It("publishing a post should notify the followers of its author", func() {
+		createPostWithAPI(c, Data{"title": "Fresh", "doc": "Out now"}, authorCookies)
+		draftID := createPostWithAPI(c, Data{"title": "Later", "doc": "Soon", "publish_at": "2100-04-01T09:00:00Z"}, authorCookies)
+
+		_, notifications := getNotifications(c, aliceCookies)
+		published := findNotification(notifications, "published")
+		c.Goblin.Assert(published["message"]).Eql("Notify-Writer published a new post")
+		c.Goblin.Assert(published["body"]).Eql("Fresh")
+
+		job := jobs.PublishScheduledPosts(c.DB)
+		c.Goblin.Assert(job.Run(c.Context, time.Date(2100, 4, 1, 9, 0, 0, 0, time.UTC))).IsNil()
+		_, notifications = getNotifications(c, bobCookies)
+		c.Goblin.Assert(notifications[0]["post_id"]).Eql(float64(draftID))
+		c.Goblin.Assert(notifications[0]["body"]).Eql("Later")
+	})
+
+	c.Goblin.It("POST /shared/:token/comments should notify the author of the draft", func() {
+		draftID := createPostWithAPI(c, Data{"doc": "Review me", "publish_at": "2100-04-01T09:00:00Z"}, bobCookies)
+		_, token := createShareLinkWithAPI(c, draftID, Data{"allow_comments": true}, bobCookies)
+		makeValidReq(c, "POST", "/shared/"+token+"/comments", Data{"name": "Reviewer", "body": "Tighten the intro"}, nil)
+
+		_, notifications := getNotifications(c, bobCookies)
+		comment := findNotification(notifications, "comment")
+		c.Goblin.Assert(comment["message"]).Eql("Reviewer commented on your post")
+		c.Goblin.Assert(comment["body"]).Eql("Tighten the intro")
+		c.Goblin.Assert(comment["actor_id"]).IsNil()
+	})
+
+	c.Goblin.It("POST /shared/:token/comments in reply should notify the signed in commenter", func() {
+		draftID := createPostWithAPI(c, Data{"doc": "Thread me", "publish_at": "2100-04-01T09:00:00Z"}, bobCookies)
+		_, token := createShareLinkWithAPI(c, draftID, Data{"allow_comments": true}, bobCookies)
+		path := "/shared/" + token + "/comments"
+		question := makeValidReq(c, "POST", path, Data{"name": "Bob", "body": "Is the ending clear?"}, bobCookies)
+		feedback := makeValidReq(c, "POST", path, Data{"name": "Reviewer", "body": "Cut the intro"}, nil)
+		makeValidReq(c, "POST", "/notifications/all/read", nil, bobCookies)
+
+		reply := makeValidReq(c, "POST", path, Data{"name": "Alice", "body": "Mostly", "parent_id": question["id"]}, aliceCookies)
+		c.Goblin.Assert(reply["parent_id"]).Eql(question["id"])
+		unread, notifications := getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(1)
+		c.Goblin.Assert(notifications[0]["type"]).Eql("reply")
+		c.Goblin.Assert(notifications[0]["message"]).Eql("Alice replied to your comment")
+		c.Goblin.Assert(notifications[0]["body"]).Eql("Mostly")
+
+		// Reviewers without an account are not notified of replies
+		makeValidReq(c, "POST", "/notifications/all/read", nil, aliceCookies)
+		makeValidReq(c, "POST", path, Data{"name": "Bob", "body": "Done", "parent_id": feedback["id"]}, bobCookies)
+		unread, _ = getNotifications(c, aliceCookies)
+		c.Goblin.Assert(unread).Eql(0)
+		unread, _ = getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(1)
+	})
+
+	c.Goblin.It("PUT /notifications/preferences should turn off a type of notification", func() {
+		prefs := makeValidReq(c, "GET", "/notifications/preferences", nil, aliceCookies)
+		c.Goblin.Assert(prefs["like"]).IsTrue()
+		c.Goblin.Assert(prefs["reply"]).IsTrue()
+
+		prefs = makeValidReq(c, "PUT", "/notifications/preferences", Data{"like": false}, aliceCookies)
+		c.Goblin.Assert(prefs["like"]).IsFalse()
+		c.Goblin.Assert(prefs["follow"]).IsTrue()
+
+		alicePostID := createPostWithAPI(c, Data{"doc": "Quiet"}, aliceCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", alicePostID), nil, bobCookies)
+		_, notifications := getNotifications(c, aliceCookies)
+		c.Goblin.Assert(findNotification(notifications, "like")).IsNil()
+	})
+}
+
+// RunNotificationsTests executes tests for follows and notifications
+func RunNotificationsTests(c *Container) {
+	c.Goblin.Describe("Notifications endpoint test", func() {
+		testNotifications(c)
+		testNotificationsWithInvalidData(c)
+	})
+}
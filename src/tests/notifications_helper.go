@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testNotificationsWithInvalidData(c *Container) {
+	c.Goblin.It("GET /notifications without a login should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/notifications",
+			"Token not found.",
+			http.StatusUnauthorized,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /notifications with an invalid cursor should return error", func() {
+		cookies := createTestUserAndLogin(c, "notify-cursor@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/notifications?cursor=not-a-cursor",
+			"Invalid cursor.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /notifications/:id/read on the notification of another user should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "notify-owner@test.com", "test-pwd")
+		followerCookies := createTestUserAndLogin(c, "notify-follower@test.com", "test-pwd")
+		followUserWithAPI(c, "notify-owner@test.com", followerCookies)
+		_, notifications := getNotifications(c, ownerCookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/notifications/%d/read", int(notifications[0]["id"].(float64))),
+			"Notification not found.",
+			http.StatusBadRequest,
+			followerCookies,
+		})
+	})
+
+	c.Goblin.It("PUT /notifications/preferences with an unknown type should return error", func() {
+		cookies := createTestUserAndLogin(c, "notify-prefs@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"newsletter": false},
+			"PUT",
+			"/notifications/preferences",
+			"Invalid notification type.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /users/:id/follow on oneself should return error", func() {
+		cookies := createTestUserAndLogin(c, "notify-self@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/users/%d/follow", getUserFromDBByEmail(c, "notify-self@test.com").ID),
+			"Users cannot follow themselves.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
@@ -147,6 +147,8 @@ func testUpdatePost(c *Container) {
 		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
 		response, err := extractResult(result)
 		c.Goblin.Assert(err).IsNil()
+
+		sample.Doc = "something-changed"
 		verifyCreatedPost(c, response, sample)
 	})
 
@@ -244,7 +244,7 @@ func testUpdatePostWithInvalidDataType(c *Container) {
 		sample := db.Post{Doc: ""}
 		post, cookies, _ := loginAndCreatePost(c, &sample, &u)
 
-		values := Data{"id": post.ID, "likes": "abs"}
+		values := Data{"id": post.ID, "title": 123}
 		c.makeInvalidReq(&errorTestCase{
 			values,
 			"PUT",
@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// scheduledDraft creates a post that stays unpublished for the duration of the tests
+func scheduledDraft(c *Container, title string, cookies []*http.Cookie) int {
+	return createPostWithAPI(c, Data{"title": title, "doc": title, "publish_at": "2100-03-01T09:00:00Z"}, cookies)
+}
+
+func submitPostWithAPI(c *Container, slug string, postID int, cookies []*http.Cookie) int {
+	response := makeValidReq(c, "POST", fmt.Sprintf("/publications/%s/submissions", slug), Data{"post_id": postID}, cookies)
+	return int(response["id"].(float64))
+}
+
+func submissionIDs(response map[string]interface{}) []int {
+	ids := []int{}
+	for _, s := range response["submissions"].([]interface{}) {
+		ids = append(ids, int(s.(map[string]interface{})["id"].(float64)))
+	}
+	return ids
+}
+
+// testPublications tests /publications to run a publication with members and a review queue
+func testPublications(c *Container) {
+	var ownerCookies, editorCookies, writerCookies []*http.Cookie
+	var editorID, writerID int
+	slug := "go-weekly"
+
+	c.Goblin.Before(func() {
+		ownerCookies = createTestUserAndLogin(c, "test-pub-owner@test.com", "test-pwd")
+		editorCookies = createTestUserAndLogin(c, "test-pub-editor@test.com", "test-pwd")
+		writerCookies = createTestUserAndLogin(c, "test-pub-writer@test.com", "test-pwd")
+		editorID = getUserFromDBByEmail(c, "test-pub-editor@test.com").ID
+		writerID = getUserFromDBByEmail(c, "test-pub-writer@test.com").ID
+	})
+
+	c.Goblin.It("POST should create a publication owned by the current user", func() {
+		response := makeValidReq(c, "POST", "/publications", Data{"name": "Go Weekly", "description": "Stories about Go"}, ownerCookies)
+		c.Goblin.Assert(response["slug"]).Eql(slug)
+
+		response = makeValidReq(c, "GET", "/publications/"+slug, nil, nil)
+		members := response["members"].([]interface{})
+		c.Goblin.Assert(len(members)).Eql(1)
+		c.Goblin.Assert(members[0].(map[string]interface{})["role"]).Eql("owner")
+	})
+
+	c.Goblin.It("PUT /:slug/members should add members with their roles", func() {
+		path := fmt.Sprintf("/publications/%s/members", slug)
+		makeValidStatusReq(c, "PUT", path, Data{"user_id": editorID, "role": "editor"}, ownerCookies)
+		makeValidStatusReq(c, "PUT", path, Data{"user_id": writerID, "role": "writer"}, ownerCookies)
+
+		members := makeValidReq(c, "GET", "/publications/"+slug, nil, nil)["members"].([]interface{})
+		c.Goblin.Assert(len(members)).Eql(3)
+		c.Goblin.Assert(members[1].(map[string]interface{})["role"]).Eql("editor")
+		c.Goblin.Assert(members[2].(map[string]interface{})["role"]).Eql("writer")
+	})
+
+	c.Goblin.It("POST /:slug/submissions should queue a draft for review", func() {
+		postID := scheduledDraft(c, "Queued draft", writerCookies)
+		submissionID := submitPostWithAPI(c, slug, postID, writerCookies)
+
+		path := fmt.Sprintf("/publications/%s/submissions", slug)
+		c.Goblin.Assert(submissionIDs(makeValidReq(c, "GET", path, nil, editorCookies))).Eql([]int{submissionID})
+		c.Goblin.Assert(submissionIDs(makeValidReq(c, "GET", path, nil, writerCookies))).Eql([]int{submissionID})
+	})
+
+	c.Goblin.It("GET /:slug/submissions should show writers only their own submissions", func() {
+		otherCookies := createTestUserAndLogin(c, "test-pub-writer2@test.com", "test-pwd")
+		otherID := getUserFromDBByEmail(c, "test-pub-writer2@test.com").ID
+		makeValidStatusReq(c, "PUT", fmt.Sprintf("/publications/%s/members", slug), Data{"user_id": otherID, "role": "writer"}, ownerCookies)
+
+		path := fmt.Sprintf("/publications/%s/submissions", slug)
+		c.Goblin.Assert(len(submissionIDs(makeValidReq(c, "GET", path, nil, otherCookies)))).Eql(0)
+	})
+
+	c.Goblin.It("POST /:slug/submissions/:id/approve should publish the post in the publication", func() {
+		postID := scheduledDraft(c, "Ready", writerCookies)
+		// Without a publish time the draft is published as soon as it is approved
+		makeValidReq(c, "DELETE", fmt.Sprintf("/posts/%d/schedule", postID), nil, writerCookies)
+		submissionID := submitPostWithAPI(c, slug, postID, writerCookies)
+
+		response := makeValidReq(c, "POST", fmt.Sprintf("/publications/%s/submissions/%d/approve", slug, submissionID), Data{"note": "Lovely"}, editorCookies)
+		c.Goblin.Assert(response["status"]).Eql("approved")
+		c.Goblin.Assert(response["note"]).Eql("Lovely")
+
+		post := getPostFromDBByID(c, postID)
+		c.Goblin.Assert(post.PublishedAt.Valid).IsTrue()
+		c.Goblin.Assert(post.PublicationID.Int).Eql(int(response["publication_id"].(float64)))
+		c.Goblin.Assert(searchResultIDs(makeValidReq(c, "GET", "/publications/"+slug, nil, nil))).Eql([]int{postID})
+	})
+
+	c.Goblin.It("POST /:slug/submissions/:id/approve should notify the followers of the writer", func() {
+		followerCookies := createTestUserAndLogin(c, "test-pub-follower@test.com", "test-pwd")
+		followUserWithAPI(c, "test-pub-writer@test.com", followerCookies)
+		postID := scheduledDraft(c, "Announced", writerCookies)
+		makeValidReq(c, "DELETE", fmt.Sprintf("/posts/%d/schedule", postID), nil, writerCookies)
+		submissionID := submitPostWithAPI(c, slug, postID, writerCookies)
+
+		unread, _ := getNotifications(c, followerCookies)
+		c.Goblin.Assert(unread).Eql(0)
+		makeValidReq(c, "POST", fmt.Sprintf("/publications/%s/submissions/%d/approve", slug, submissionID), nil, editorCookies)
+
+		unread, notifications := getNotifications(c, followerCookies)
+		c.Goblin.Assert(unread).Eql(1)
+		published := findNotification(notifications, "published")
+		c.Goblin.Assert(published["post_id"]).Eql(float64(postID))
+		c.Goblin.Assert(published["body"]).Eql("Announced")
+	})
+
+	c.Goblin.It("POST /:slug/submissions/:id/reject should keep the post a draft", func() {
+		postID := scheduledDraft(c, "Needs work", writerCookies)
+		submissionID := submitPostWithAPI(c, slug, postID, writerCookies)
+
+		response := makeValidReq(c, "POST", fmt.Sprintf("/publications/%s/submissions/%d/reject", slug, submissionID), Data{"note": "Too short"}, editorCookies)
+		c.Goblin.Assert(response["status"]).Eql("rejected")
+
+		post := getPostFromDBByID(c, postID)
+		c.Goblin.Assert(post.PublishedAt.Valid).IsFalse()
+		c.Goblin.Assert(post.PublicationID.Valid).IsFalse()
+
+		rejected := makeValidReq(c, "GET", fmt.Sprintf("/publications/%s/submissions?status=rejected", slug), nil, writerCookies)
+		c.Goblin.Assert(submissionIDs(rejected)).Eql([]int{submissionID})
+	})
+
+	c.Goblin.It("PUT /posts should let editors update posts of the publication", func() {
+		postID := scheduledDraft(c, "Edited by an editor", writerCookies)
+		submissionID := submitPostWithAPI(c, slug, postID, writerCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/publications/%s/submissions/%d/approve", slug, submissionID), nil, editorCookies)
+
+		response := updatePostWithAPI(c, Data{"id": postID, "title": "Edited by an editor", "doc": "polished"}, editorCookies)
+		c.Goblin.Assert(response["doc"]).Eql("polished")
+	})
+
+	testPublicationsWithInvalidData(c)
+}
+
+// RunPublicationsTests executes all tests for /publications
+func RunPublicationsTests(c *Container) {
+	c.Goblin.Describe("API /publications", func() {
+		// POST /publications, GET /publications/:slug, PUT, DELETE /publications/:slug/members,
+		// GET, POST /publications/:slug/submissions, POST approve/reject
+		testPublications(c)
+	})
+}
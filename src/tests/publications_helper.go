@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testPublicationsWithInvalidData(c *Container) {
+	c.Goblin.It("POST with a taken name should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-taken@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Taken Name"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"name": "taken name"},
+			"POST",
+			"/publications",
+			"Publication already exists.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("GET with an unknown slug should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/publications/no-such-publication",
+			"Publication not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("PUT /:slug/members by a non-owner should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-pub-boss@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Bossy"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-pub-outsider@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-pub-outsider@test.com").ID
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"user_id": userID, "role": "owner"},
+			"PUT",
+			"/publications/bossy/members",
+			"User is not an owner of the publication.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /:slug/members with an invalid role should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-roles@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Roles"}, cookies)
+		userID := getUserFromDBByEmail(c, "test-pub-roles@test.com").ID
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"user_id": userID, "role": "publisher"},
+			"PUT",
+			"/publications/roles/members",
+			"Invalid role.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("DELETE /:slug/members/:user_id of the last owner should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-alone@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Alone"}, cookies)
+		userID := getUserFromDBByEmail(c, "test-pub-alone@test.com").ID
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			fmt.Sprintf("/publications/alone/members/%d", userID),
+			"A publication needs an owner.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:slug/submissions by a non-member should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-pub-closed@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Closed"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-pub-stranger@test.com", "test-pwd")
+		postID := scheduledDraft(c, "Uninvited", cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			"/publications/closed/submissions",
+			"User is not a member of the publication.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:slug/submissions with a published post should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-published@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Published"}, cookies)
+		postID := createPostWithAPI(c, Data{"doc": "already out"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			"/publications/published/submissions",
+			"Only unpublished posts can be submitted.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:slug/submissions twice should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-twice@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Twice"}, cookies)
+		postID := scheduledDraft(c, "Once is enough", cookies)
+		submitPostWithAPI(c, "twice", postID, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			"/publications/twice/submissions",
+			"Post is already in a publication or awaiting review.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:slug/submissions/:id/approve by a writer should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-pub-chief@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Chief"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-pub-eager@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-pub-eager@test.com").ID
+		makeValidStatusReq(c, "PUT", "/publications/chief/members", Data{"user_id": userID, "role": "writer"}, ownerCookies)
+		submissionID := submitPostWithAPI(c, "chief", scheduledDraft(c, "Self approved", cookies), cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/publications/chief/submissions/%d/approve", submissionID),
+			"User is not an editor of the publication.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:slug/submissions/:id/reject after a review should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-pub-reviewed@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Reviewed"}, cookies)
+		submissionID := submitPostWithAPI(c, "reviewed", scheduledDraft(c, "Decided", cookies), cookies)
+		path := fmt.Sprintf("/publications/reviewed/submissions/%d", submissionID)
+		makeValidReq(c, "POST", path+"/approve", nil, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"note": "Changed my mind"},
+			"POST",
+			path + "/reject",
+			"Submission was already reviewed.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /posts by a writer of the publication should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-pub-keeper@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/publications", Data{"name": "Keeper"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-pub-meddler@test.com", "test-pwd")
+		userID := getUserFromDBByEmail(c, "test-pub-meddler@test.com").ID
+		makeValidStatusReq(c, "PUT", "/publications/keeper/members", Data{"user_id": userID, "role": "writer"}, ownerCookies)
+		postID := scheduledDraft(c, "Kept", ownerCookies)
+		submissionID := submitPostWithAPI(c, "keeper", postID, ownerCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/publications/keeper/submissions/%d/approve", submissionID), nil, ownerCookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"id": postID, "doc": "meddled"},
+			"PUT",
+			"/posts",
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
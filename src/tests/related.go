@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func relatedPostIDs(c *Container, id int, query string) []int {
+	response := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/related?%s", id, query), nil, nil)
+	return searchResultIDs(response)
+}
+
+func containsID(ids []int, id int) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// testGetRelatedPosts tests /posts/:id/related to suggest what to read next
+func testGetRelatedPosts(c *Container) {
+	var authorCookies []*http.Cookie
+	var source, sameTags, oneTag, sameAuthor, coLiked, scheduled int
+
+	c.Goblin.Before(func() {
+		authorCookies = createTestUserAndLogin(c, "test-related-author@test.com", "test-pwd")
+		otherCookies := createTestUserAndLogin(c, "test-related-other@test.com", "test-pwd")
+		readerCookies := createTestUserAndLogin(c, "test-related-reader@test.com", "test-pwd")
+
+		source = createPostWithAPI(c, Data{"title": "Sourdough starter guide", "doc": "flour", "tags": "sourdough,baking"}, authorCookies)
+		sameTags = createPostWithAPI(c, Data{"title": "Rye loaves", "doc": "rye", "tags": "baking,sourdough"}, otherCookies)
+		oneTag = createPostWithAPI(c, Data{"title": "Oven temperatures", "doc": "heat", "tags": "sourdough"}, otherCookies)
+		sameAuthor = createPostWithAPI(c, Data{"title": "Weekend notes", "doc": "notes"}, authorCookies)
+		coLiked = createPostWithAPI(c, Data{"title": "Knife sharpening", "doc": "steel"}, otherCookies)
+		scheduled = createPostWithAPI(c, Data{"title": "Sourdough starter guide", "doc": "later", "tags": "sourdough,baking", "publish_at": "2100-02-01T09:00:00Z"}, otherCookies)
+
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", source), nil, readerCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", coLiked), nil, readerCookies)
+	})
+
+	c.Goblin.It("GET should rank shared tags, then a shared author", func() {
+		c.Goblin.Assert(relatedPostIDs(c, source, "limit=3")).Eql([]int{sameTags, oneTag, sameAuthor})
+	})
+
+	c.Goblin.It("GET should relate posts liked by the same readers", func() {
+		c.Goblin.Assert(containsID(relatedPostIDs(c, source, "limit=20"), coLiked)).IsTrue()
+	})
+
+	c.Goblin.It("GET should leave out the post itself and unpublished posts", func() {
+		ids := relatedPostIDs(c, source, "limit=20")
+		c.Goblin.Assert(containsID(ids, source)).IsFalse()
+		c.Goblin.Assert(containsID(ids, scheduled)).IsFalse()
+	})
+
+	c.Goblin.It("GET should serve cached results until the tags of a post change", func() {
+		relatedPostIDs(c, source, "limit=20")
+		newer := createPostWithAPI(c, Data{"title": "Late addition", "doc": "new"}, authorCookies)
+		c.Goblin.Assert(containsID(relatedPostIDs(c, source, "limit=20"), newer)).IsFalse()
+
+		updatePostWithAPI(c, Data{"id": newer, "tags": "sourdough,baking"}, authorCookies)
+		c.Goblin.Assert(relatedPostIDs(c, source, "limit=1")).Eql([]int{newer})
+	})
+
+	testGetRelatedPostsWithInvalidQuery(c)
+}
+
+// testLikePost tests /posts/:id/like to like and unlike posts
+func testLikePost(c *Container) {
+	c.Goblin.It("POST should count the like of a user once", func() {
+		cookies := createTestUserAndLogin(c, "test-like@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "Likes", "doc": "like me"}, cookies)
+		path := fmt.Sprintf("/posts/%d/like", id)
+
+		response := makeValidReq(c, "POST", path, nil, cookies)
+		c.Goblin.Assert(response["likes"]).Eql(float64(1))
+
+		response = makeValidReq(c, "POST", path, nil, cookies)
+		c.Goblin.Assert(response["likes"]).Eql(float64(1))
+	})
+
+	c.Goblin.It("DELETE should remove the like of a user", func() {
+		cookies := createTestUserAndLogin(c, "test-unlike@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "Likes", "doc": "unlike me"}, cookies)
+		path := fmt.Sprintf("/posts/%d/like", id)
+
+		makeValidReq(c, "POST", path, nil, cookies)
+		response := makeValidReq(c, "DELETE", path, nil, cookies)
+		c.Goblin.Assert(response["likes"]).Eql(float64(0))
+
+		response = makeValidReq(c, "DELETE", path, nil, cookies)
+		c.Goblin.Assert(response["likes"]).Eql(float64(0))
+	})
+
+	testLikePostWithInvalidID(c)
+}
+
+// RunRelatedTests executes all tests for /posts/:id/related and /posts/:id/like
+func RunRelatedTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/related", func() {
+		// GET /posts/:id/related
+		testGetRelatedPosts(c)
+	})
+
+	c.Goblin.Describe("API /posts/:id/like", func() {
+		// POST, DELETE /posts/:id/like
+		testLikePost(c)
+	})
+}
@@ -0,0 +1,42 @@
+package tests
+
+import "net/http"
+
+func testGetRelatedPostsWithInvalidQuery(c *Container) {
+	c.Goblin.It("GET with an unknown post should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/posts/999999/related",
+			"Post not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET with too many posts should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/posts/1/related?limit=50",
+			"Invalid limit.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
+
+func testLikePostWithInvalidID(c *Container) {
+	c.Goblin.It("POST with an unknown post should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-like-invalid@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			"/posts/999999/like",
+			"Post not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
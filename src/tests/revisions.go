@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/diff"
+)
+
+// testGetRevisions tests /posts/:id/revisions to list revisions of a post
+func testGetRevisions(c *Container) {
+	c.Goblin.It("/:id/revisions GET should return a revision for each version of a post", func() {
+		cookies := createTestUserAndLogin(c, "test-get-revisions@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "first", "doc": "line one"}, cookies)
+		updatePostWithAPI(c, Data{"id": postID, "doc": "line one\nline two"}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d/revisions", postID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["total_count"]).Eql(float64(2))
+
+		revisions := response["revisions"].([]interface{})
+		first := revisions[0].(map[string]interface{})
+		c.Goblin.Assert(first["revision"]).Eql(float64(1))
+		c.Goblin.Assert(first["doc"]).Eql("line one")
+		c.Goblin.Assert(first["editor"]).Eql("test-get-revisions")
+	})
+}
+
+// testGetRevision tests /posts/:id/revisions/:rev to retrieve a single revision
+func testGetRevision(c *Container) {
+	c.Goblin.It("/:id/revisions/:rev GET should return the revision", func() {
+		cookies := createTestUserAndLogin(c, "test-get-revision@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "first", "doc": "original"}, cookies)
+		updatePostWithAPI(c, Data{"id": postID, "title": "second", "doc": "changed"}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d/revisions/2", postID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["revision"]).Eql(float64(2))
+		c.Goblin.Assert(response["title"]).Eql("second")
+		c.Goblin.Assert(response["doc"]).Eql("changed")
+	})
+
+	testGetRevisionNotFound(c)
+
+	testGetRevisionsOfScheduledPost(c)
+}
+
+// testGetRevisionDiff tests /posts/:id/diff to compare two revisions
+func testGetRevisionDiff(c *Container) {
+	c.Goblin.It("/:id/diff GET should return a line-based diff of two revisions", func() {
+		cookies := createTestUserAndLogin(c, "test-revision-diff@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "one\ntwo\nthree"}, cookies)
+		updatePostWithAPI(c, Data{"id": postID, "doc": "one\n2\nthree\nfour"}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d/diff?from=1&to=2", postID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["insertions"]).Eql(float64(2))
+		c.Goblin.Assert(response["deletions"]).Eql(float64(1))
+	})
+
+	c.Goblin.It("diff.Lines should keep common lines and mark changed ones", func() {
+		lines := diff.Lines("a\nb\nc", "a\nc\nd")
+		c.Goblin.Assert(lines).Eql([]diff.Line{
+			{Op: diff.Equal, Text: "a"},
+			{Op: diff.Delete, Text: "b"},
+			{Op: diff.Equal, Text: "c"},
+			{Op: diff.Insert, Text: "d"},
+		})
+	})
+
+	c.Goblin.It("diff.Lines should not compare more than diff.MaxComparisons line pairs", func() {
+		var a, b []string
+		for i := 0; i < 1500; i++ {
+			a = append(a, fmt.Sprintf("old %d", i))
+			b = append(b, fmt.Sprintf("new %d", i))
+		}
+		lines := diff.Lines("same\n"+strings.Join(a, "\n"), "same\n"+strings.Join(b, "\n"))
+		c.Goblin.Assert(len(lines)).Eql(3001)
+		c.Goblin.Assert(lines[1]).Eql(diff.Line{Op: diff.Delete, Text: "old 0"})
+		c.Goblin.Assert(lines[1501]).Eql(diff.Line{Op: diff.Insert, Text: "new 0"})
+	})
+
+	testGetRevisionDiffWithInvalidRevision(c)
+}
+
+// testRestoreRevision tests /posts/:id/revisions/:rev/restore
+func testRestoreRevision(c *Container) {
+	c.Goblin.It("/:id/revisions/:rev/restore POST should restore the revision as a new revision", func() {
+		cookies := createTestUserAndLogin(c, "test-restore-revision@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"title": "first", "doc": "original"}, cookies)
+		updatePostWithAPI(c, Data{"id": postID, "title": "second", "doc": "changed"}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "POST",
+			path:    fmt.Sprintf("/posts/%d/revisions/1/restore", postID),
+			reqBody: nil,
+			cookie:  cookies,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["title"]).Eql("first")
+		c.Goblin.Assert(response["doc"]).Eql("original")
+
+		revisionResult := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    fmt.Sprintf("/posts/%d/revisions/3", postID),
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(revisionResult.Code).Eql(http.StatusOK)
+
+		revision, err := extractResult(revisionResult)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(revision["doc"]).Eql("original")
+	})
+
+	testRestoreRevisionWithInvalidUser(c)
+}
+
+// RunRevisionsTests executes all tests for /posts/:id/revisions
+func RunRevisionsTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/revisions", func() {
+		// GET /posts/:id/revisions
+		testGetRevisions(c)
+
+		// GET /posts/:id/revisions/:rev
+		testGetRevision(c)
+
+		// GET /posts/:id/diff?from=1&to=2
+		testGetRevisionDiff(c)
+
+		// POST /posts/:id/revisions/:rev/restore
+		testRestoreRevision(c)
+	})
+}
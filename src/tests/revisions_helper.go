@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testGetRevisionNotFound(c *Container) {
+	c.Goblin.It("/:id/revisions/:rev GET with unknown revision should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-get-revision2@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "original"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d/revisions/5", postID),
+			"Revision not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
+
+func testGetRevisionsOfScheduledPost(c *Container) {
+	c.Goblin.It("/:id/revisions GET on a scheduled post of another user should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-revisions-draft@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "not yet", "publish_at": "2100-02-01T09:00:00Z"}, cookies)
+
+		for _, path := range []string{"/posts/%d/revisions", "/posts/%d/revisions/1", "/posts/%d/diff?from=1&to=1"} {
+			c.makeInvalidReq(&errorTestCase{
+				nil,
+				"GET",
+				fmt.Sprintf(path, postID),
+				"Post not found.",
+				http.StatusBadRequest,
+				nil,
+			})
+		}
+		makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/revisions/1", postID), nil, cookies)
+	})
+}
+
+func testGetRevisionDiffWithInvalidRevision(c *Container) {
+	c.Goblin.It("/:id/diff GET with invalid revision should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-revision-diff2@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "original"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d/diff?from=1&to=abc", postID),
+			"Invalid revision.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
+
+func testRestoreRevisionWithInvalidUser(c *Container) {
+	c.Goblin.It("/:id/revisions/:rev/restore POST with invalid user should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-restore-revision2@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "original"}, cookies)
+		otherCookies := createTestUserAndLogin(c, "test-restore-revision3@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/revisions/1/restore", postID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			otherCookies,
+		})
+	})
+}
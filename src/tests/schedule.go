@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+)
+
+// testSchedulePost tests /posts/:id/schedule to schedule a post
+func testSchedulePost(c *Container) {
+	c.Goblin.It("/:id/schedule PUT should schedule an unpublished post", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-post@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, draft, &u)
+
+		values := Data{"publish_at": "2100-02-01T09:00:00Z"}
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "PUT",
+			path:    fmt.Sprintf("/posts/%d/schedule", post.ID),
+			reqBody: &values,
+			cookie:  cookies,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["publish_at"]).Eql("2100-02-01T09:00:00Z")
+		c.Goblin.Assert(response["published_at"]).IsNil()
+	})
+
+	c.Goblin.It("GET should not list scheduled posts", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-hidden@test.com", "test-pwd", ""}
+		loginAndCreatePost(c, draft, &u)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    "/posts?author=test-schedule-hidden",
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["total_count"]).Eql(float64(0))
+	})
+
+	c.Goblin.It("GET /:id should only show a scheduled post to its authors", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-visible@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, draft, &u)
+
+		response := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d", post.ID), nil, cookies)
+		c.Goblin.Assert(response["id"]).Eql(float64(post.ID))
+	})
+
+	testGetScheduledPost(c)
+
+	testSchedulePostInThePast(c)
+
+	testSchedulePostAlreadyPublished(c)
+
+	testSchedulePostWithInvalidUser(c)
+}
+
+// testCancelScheduledPost tests /posts/:id/schedule to cancel a scheduled post
+func testCancelScheduledPost(c *Container) {
+	c.Goblin.It("/:id/schedule DELETE should keep the post as a draft", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-cancel-schedule@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, draft, &u)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "DELETE",
+			path:    fmt.Sprintf("/posts/%d/schedule", post.ID),
+			reqBody: nil,
+			cookie:  cookies,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		cancelled := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(cancelled.PublishAt.Valid).IsFalse()
+		c.Goblin.Assert(cancelled.PublishedAt.Valid).IsFalse()
+	})
+
+	testCancelUnscheduledPost(c)
+}
+
+// testPublishScheduledPosts tests the background job publishing due posts
+func testPublishScheduledPosts(c *Container) {
+	c.Goblin.It("publish job should publish a due post exactly once", func() {
+		publishAt := time.Date(2100, time.March, 1, 9, 0, 0, 0, time.UTC)
+		draft := &db.Post{Doc: "scheduled", PublishAt: publishAt}
+		u := userInfo{"test-publish-job@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, draft, &u)
+		job := jobs.PublishScheduledPosts(c.DB)
+
+		err := job.Run(c.Context, publishAt.Add(-time.Minute))
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(getPostFromDBByID(c, post.ID).PublishedAt.Valid).IsFalse()
+
+		err = job.Run(c.Context, publishAt)
+		c.Goblin.Assert(err).IsNil()
+		published := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(published.PublishedAt.Time.Equal(publishAt)).IsTrue()
+
+		err = job.Run(c.Context, publishAt.Add(time.Hour))
+		c.Goblin.Assert(err).IsNil()
+		republished := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(republished.PublishedAt.Time.Equal(publishAt)).IsTrue()
+	})
+
+	c.Goblin.It("rescheduling a post the job has published should keep it published", func() {
+		publishAt := time.Date(2100, time.March, 2, 9, 0, 0, 0, time.UTC)
+		draft := &db.Post{Doc: "scheduled", PublishAt: publishAt}
+		u := userInfo{"test-publish-race@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, draft, &u)
+
+		_, err := db.PublishDuePosts(c.Context, c.DB, publishAt, 100)
+		c.Goblin.Assert(err).IsNil()
+
+		_, err = db.SchedulePost(c.Context, c.DB, int64(post.ID), publishAt.Add(time.Hour))
+		c.Goblin.Assert(err).Eql(db.ErrPostPublished)
+		_, err = db.UnschedulePost(c.Context, c.DB, int64(post.ID))
+		c.Goblin.Assert(err).Eql(db.ErrPostPublished)
+
+		published := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(published.PublishedAt.Time.Equal(publishAt)).IsTrue()
+		c.Goblin.Assert(published.PublishAt.Time.Equal(publishAt)).IsTrue()
+	})
+
+	c.Goblin.It("concurrent schedulers should publish a due post only once", func() {
+		publishAt := time.Date(2100, time.April, 1, 9, 0, 0, 0, time.UTC)
+		draft := &db.Post{Doc: "scheduled", PublishAt: publishAt}
+		u := userInfo{"test-publish-job2@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, draft, &u)
+
+		var wg sync.WaitGroup
+		var mutex sync.Mutex
+		publishCount := 0
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				posts, err := db.PublishDuePosts(c.Context, c.DB, publishAt, 100)
+				if err != nil {
+					return
+				}
+				mutex.Lock()
+				defer mutex.Unlock()
+				for _, p := range *posts {
+					if p.ID == post.ID {
+						publishCount++
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		c.Goblin.Assert(publishCount).Eql(1)
+	})
+}
+
+// RunScheduleTests executes all tests for scheduled publishing
+func RunScheduleTests(c *Container) {
+	c.Goblin.Describe("API /posts/:id/schedule", func() {
+		// PUT /posts/:id/schedule with json {publish_at: 2100-02-01T09:00:00Z}
+		testSchedulePost(c)
+
+		// DELETE /posts/:id/schedule
+		testCancelScheduledPost(c)
+
+		// Background publish job
+		testPublishScheduledPosts(c)
+	})
+}
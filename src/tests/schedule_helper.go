@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+func testGetScheduledPost(c *Container) {
+	var post *models.Post
+	var readerCookies []*http.Cookie
+
+	c.Goblin.Before(func() {
+		draft := &db.Post{Doc: "secret draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-secret@test.com", "test-pwd", ""}
+		post, _, _ = loginAndCreatePost(c, draft, &u)
+		readerCookies = createTestUserAndLogin(c, "test-schedule-reader@test.com", "test-pwd")
+	})
+
+	c.Goblin.It("/:id GET on a scheduled post of another user should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d", post.ID),
+			"Post not found.",
+			http.StatusBadRequest,
+			readerCookies,
+		})
+	})
+
+	c.Goblin.It("/:id/like POST on a scheduled post should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/like", post.ID),
+			"Post not found.",
+			http.StatusBadRequest,
+			readerCookies,
+		})
+	})
+
+	c.Goblin.It("/:id/related GET on a scheduled post should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d/related", post.ID),
+			"Post not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("/stream GET on the likes of a scheduled post should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/stream?topics=post:%d:likes", post.ID),
+			"Post not found.",
+			http.StatusBadRequest,
+			readerCookies,
+		})
+	})
+}
+
+func testSchedulePostInThePast(c *Container) {
+	c.Goblin.It("/:id/schedule PUT with a past time should return error", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-past@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, draft, &u)
+
+		values := Data{"publish_at": "2001-01-01T00:00:00Z"}
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"PUT",
+			fmt.Sprintf("/posts/%d/schedule", post.ID),
+			"Invalid publish time.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
+
+func testSchedulePostAlreadyPublished(c *Container) {
+	c.Goblin.It("/:id/schedule PUT on a published post should return error", func() {
+		sample := &db.Post{Doc: "published"}
+		u := userInfo{"test-schedule-published@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, sample, &u)
+
+		values := Data{"publish_at": "2100-01-01T00:00:00Z"}
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"PUT",
+			fmt.Sprintf("/posts/%d/schedule", post.ID),
+			"Post already published.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
+
+func testSchedulePostWithInvalidUser(c *Container) {
+	c.Goblin.It("/:id/schedule PUT with invalid user should return error", func() {
+		draft := &db.Post{Doc: "draft", PublishAt: time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		u := userInfo{"test-schedule-author@test.com", "test-pwd", ""}
+		post, _, _ := loginAndCreatePost(c, draft, &u)
+
+		createTestUser(c, "test-schedule-other@test.com", "test-pwd")
+		loginResult := login(c, "test-schedule-other@test.com", "test-pwd")
+
+		values := Data{"publish_at": "2100-01-01T00:00:00Z"}
+		c.makeInvalidReq(&errorTestCase{
+			values,
+			"PUT",
+			fmt.Sprintf("/posts/%d/schedule", post.ID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			loginResult.Result().Cookies(),
+		})
+	})
+}
+
+func testCancelUnscheduledPost(c *Container) {
+	c.Goblin.It("/:id/schedule DELETE on a published post should return error", func() {
+		sample := &db.Post{Doc: "published"}
+		u := userInfo{"test-cancel-published@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, sample, &u)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			fmt.Sprintf("/posts/%d/schedule", post.ID),
+			"Post already published.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
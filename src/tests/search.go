@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func searchWithAPI(c *Container, query string) map[string]interface{} {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  "GET",
+		path:    "/search?" + query,
+		reqBody: nil,
+		cookie:  nil,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	return response
+}
+
+func searchResultIDs(response map[string]interface{}) []int {
+	var ids []int
+	for _, p := range response["posts"].([]interface{}) {
+		ids = append(ids, int(p.(map[string]interface{})["id"].(float64)))
+	}
+	return ids
+}
+
+// testSearchPosts tests /search to find posts by their content
+func testSearchPosts(c *Container) {
+	c.Goblin.It("GET should rank title matches above body matches", func() {
+		cookies := createTestUserAndLogin(c, "test-search-rank@test.com", "test-pwd")
+		bodyID := createPostWithAPI(c, Data{"title": "Gardening", "doc": "Growing zucchinis in spring"}, cookies)
+		titleID := createPostWithAPI(c, Data{"title": "Zucchini recipes", "doc": "Cooking at home"}, cookies)
+
+		response := searchWithAPI(c, "q=zucchini")
+		c.Goblin.Assert(response["total_count"]).Eql(float64(2))
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{titleID, bodyID})
+	})
+
+	c.Goblin.It("GET should highlight the matches in an escaped snippet", func() {
+		cookies := createTestUserAndLogin(c, "test-search-snippet@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"title": "Snippets", "doc": "<b>Bold</b> claims about aubergines"}, cookies)
+
+		response := searchWithAPI(c, "q=aubergine")
+		post := response["posts"].([]interface{})[0].(map[string]interface{})
+		snippet := post["snippet"].(string)
+		c.Goblin.Assert(strings.Contains(snippet, "<mark>aubergines</mark>")).IsTrue()
+		c.Goblin.Assert(strings.Contains(snippet, "&lt;b&gt;")).IsTrue()
+	})
+
+	c.Goblin.It("GET should support phrases and excluded words", func() {
+		cookies := createTestUserAndLogin(c, "test-search-syntax@test.com", "test-pwd")
+		wantedID := createPostWithAPI(c, Data{"title": "Syntax", "doc": "purple carrots are sweet"}, cookies)
+		createPostWithAPI(c, Data{"title": "Syntax", "doc": "purple carrots are bitter"}, cookies)
+		createPostWithAPI(c, Data{"title": "Syntax", "doc": "carrots that are purple"}, cookies)
+
+		response := searchWithAPI(c, "q="+strings.ReplaceAll(`"purple carrots" -bitter`, " ", "+"))
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{wantedID})
+	})
+
+	c.Goblin.It("GET should combine the search with tag and author filters", func() {
+		cookies := createTestUserAndLogin(c, "test-search-filter@test.com", "test-pwd")
+		taggedID := createPostWithAPI(c, Data{"title": "Filters", "doc": "about parsnips", "tags": "roots,winter"}, cookies)
+		createPostWithAPI(c, Data{"title": "Filters", "doc": "about parsnips", "tags": "roots"}, cookies)
+
+		response := searchWithAPI(c, "q=parsnip&tags=roots,winter&author=test-search-filter")
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{taggedID})
+
+		response = searchWithAPI(c, "q=parsnip&author=someone-else")
+		c.Goblin.Assert(response["total_count"]).Eql(float64(0))
+
+		// Filters are read like those of GET /posts
+		response = searchWithAPI(c, "q=parsnip&tags=Winter&author=someone-else,test-search-filter")
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{taggedID})
+	})
+
+	c.Goblin.It("GET should paginate the results", func() {
+		cookies := createTestUserAndLogin(c, "test-search-page@test.com", "test-pwd")
+		for i := 0; i < 3; i++ {
+			createPostWithAPI(c, Data{"title": fmt.Sprintf("Page %d", i), "doc": "radishes"}, cookies)
+		}
+
+		response := searchWithAPI(c, "q=radish&page=2&per_page=2")
+		c.Goblin.Assert(response["total_count"]).Eql(float64(3))
+		c.Goblin.Assert(response["page"]).Eql(float64(2))
+		c.Goblin.Assert(len(searchResultIDs(response))).Eql(1)
+	})
+
+	testSearchPostsWithInvalidQuery(c)
+}
+
+// RunSearchTests executes all tests for /search
+func RunSearchTests(c *Container) {
+	c.Goblin.Describe("API /search", func() {
+		// GET /search
+		testSearchPosts(c)
+
+		// GET /search/suggest
+		testSuggest(c)
+	})
+}
+
+func suggestWithAPI(c *Container, query string) []interface{} {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  "GET",
+		path:    "/search/suggest?" + query,
+		reqBody: nil,
+		cookie:  nil,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	c.Goblin.Assert(response["partial"]).IsFalse()
+	return response["suggestions"].([]interface{})
+}
+
+func findSuggestion(suggestions []interface{}, suggestionType string, text string) map[string]interface{} {
+	for _, s := range suggestions {
+		suggestion := s.(map[string]interface{})
+		if suggestion["type"] == suggestionType && suggestion["text"] == text {
+			return suggestion
+		}
+	}
+	return nil
+}
+
+// testSuggest tests /search/suggest to complete partial queries
+func testSuggest(c *Container) {
+	c.Goblin.It("GET should suggest tags and titles despite typos", func() {
+		cookies := createTestUserAndLogin(c, "test-suggest-typo@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "Kohlrabi season", "doc": "text", "tags": "kohlrabi"}, cookies)
+
+		suggestions := suggestWithAPI(c, "q=kolrabi")
+		c.Goblin.Assert(findSuggestion(suggestions, "tag", "kohlrabi")).IsNotNil()
+
+		title := findSuggestion(suggestions, "title", "Kohlrabi season")
+		c.Goblin.Assert(title).IsNotNil()
+		c.Goblin.Assert(title["post_id"]).Eql(float64(id))
+	})
+
+	c.Goblin.It("GET should suggest author handles", func() {
+		cookies := createTestUserAndLogin(c, "suggested-writer@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"title": "Anything", "doc": "text"}, cookies)
+
+		suggestions := suggestWithAPI(c, "q=suggested-wri")
+		c.Goblin.Assert(findSuggestion(suggestions, "author", "suggested-writer")).IsNotNil()
+	})
+
+	c.Goblin.It("GET should rank closer matches first", func() {
+		cookies := createTestUserAndLogin(c, "test-suggest-rank@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"title": "Rutabaga", "doc": "text"}, cookies)
+		createPostWithAPI(c, Data{"title": "Rutabaga and other roots", "doc": "text"}, cookies)
+
+		suggestions := suggestWithAPI(c, "q=rutabaga&limit=1")
+		c.Goblin.Assert(len(suggestions)).Eql(1)
+		c.Goblin.Assert(suggestions[0].(map[string]interface{})["text"]).Eql("Rutabaga")
+	})
+
+	testSuggestWithInvalidQuery(c)
+}
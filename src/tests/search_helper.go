@@ -0,0 +1,51 @@
+package tests
+
+import "net/http"
+
+func testSearchPostsWithInvalidQuery(c *Container) {
+	c.Goblin.It("GET without a query should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/search?q=",
+			"Query required.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET with an invalid page should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/search?q=test&page=0",
+			"Invalid page.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
+
+func testSuggestWithInvalidQuery(c *Container) {
+	c.Goblin.It("GET /suggest without a query should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/search/suggest",
+			"Query required.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /suggest with too many suggestions should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/search/suggest?q=test&limit=500",
+			"Invalid limit.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func createSeriesWithAPI(c *Container, values Data, cookies []*http.Cookie) int {
+	response := makeValidReq(c, "POST", "/series", values, cookies)
+	return int(response["id"].(float64))
+}
+
+func seriesPart(c *Container, postID int) map[string]interface{} {
+	response := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d", postID), nil, nil)
+	return response["series"].(map[string]interface{})
+}
+
+func seriesLink(part map[string]interface{}, direction string) interface{} {
+	if link, ok := part[direction].(map[string]interface{}); ok {
+		return int(link["id"].(float64))
+	}
+	return nil
+}
+
+// testSeries tests /series to collect posts into ordered parts
+func testSeries(c *Container) {
+	var cookies []*http.Cookie
+	var seriesID, first, second, third int
+
+	c.Goblin.Before(func() {
+		cookies = createTestUserAndLogin(c, "test-series@test.com", "test-pwd")
+		seriesID = createSeriesWithAPI(c, Data{"title": "Go in three parts", "description": "A tutorial"}, cookies)
+		first = createPostWithAPI(c, Data{"title": "Part one", "doc": "one"}, cookies)
+		second = createPostWithAPI(c, Data{"title": "Part two", "doc": "two"}, cookies)
+		third = createPostWithAPI(c, Data{"title": "Part three", "doc": "three"}, cookies)
+		for _, id := range []int{first, second, third} {
+			makeValidStatusReq(c, "POST", fmt.Sprintf("/series/%d/posts", seriesID), Data{"post_id": id}, cookies)
+		}
+	})
+
+	c.Goblin.It("GET /:id should list the posts of the series in order", func() {
+		response := makeValidReq(c, "GET", fmt.Sprintf("/series/%d", seriesID), nil, nil)
+		c.Goblin.Assert(response["title"]).Eql("Go in three parts")
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{first, second, third})
+	})
+
+	c.Goblin.It("GET /posts/:id should link to the neighbouring parts", func() {
+		part := seriesPart(c, second)
+		c.Goblin.Assert(part["id"]).Eql(float64(seriesID))
+		c.Goblin.Assert(part["part"]).Eql(float64(2))
+		c.Goblin.Assert(part["parts"]).Eql(float64(3))
+		c.Goblin.Assert(seriesLink(part, "previous")).Eql(first)
+		c.Goblin.Assert(seriesLink(part, "next")).Eql(third)
+
+		_, inSeries := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d", createPostWithAPI(c, Data{"doc": "alone"}, cookies)), nil, nil)["series"]
+		c.Goblin.Assert(inSeries).IsFalse()
+	})
+
+	c.Goblin.It("PUT /:id/posts should reorder the parts", func() {
+		makeValidStatusReq(c, "PUT", fmt.Sprintf("/series/%d/posts", seriesID), Data{"post_ids": []int{third, first, second}}, cookies)
+
+		part := seriesPart(c, third)
+		c.Goblin.Assert(part["part"]).Eql(float64(1))
+		c.Goblin.Assert(seriesLink(part, "previous")).IsNil()
+		c.Goblin.Assert(seriesLink(part, "next")).Eql(first)
+	})
+
+	c.Goblin.It("GET /:id should only show unpublished parts to the author", func() {
+		scheduled := createPostWithAPI(c, Data{"title": "Part four", "doc": "soon", "publish_at": "2100-02-01T09:00:00Z"}, cookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/series/%d/posts", seriesID), Data{"post_id": scheduled}, cookies)
+		path := fmt.Sprintf("/series/%d", seriesID)
+
+		c.Goblin.Assert(len(searchResultIDs(makeValidReq(c, "GET", path, nil, nil)))).Eql(3)
+		c.Goblin.Assert(len(searchResultIDs(makeValidReq(c, "GET", path, nil, cookies)))).Eql(4)
+		c.Goblin.Assert(seriesPart(c, second)["parts"]).Eql(float64(3))
+	})
+
+	c.Goblin.It("DELETE /:id/posts/:post_id should remove a part", func() {
+		makeValidStatusReq(c, "DELETE", fmt.Sprintf("/series/%d/posts/%d", seriesID, first), nil, cookies)
+
+		part := seriesPart(c, second)
+		c.Goblin.Assert(part["parts"]).Eql(float64(2))
+		c.Goblin.Assert(seriesLink(part, "previous")).Eql(third)
+	})
+
+	testSeriesWithInvalidData(c)
+}
+
+// RunSeriesTests executes all tests for /series
+func RunSeriesTests(c *Container) {
+	c.Goblin.Describe("API /series", func() {
+		// POST /series, GET /series/:id, POST, PUT, DELETE /series/:id/posts
+		testSeries(c)
+	})
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testSeriesWithInvalidData(c *Container) {
+	c.Goblin.It("POST without a title should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-series-no-title@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"description": "untitled"},
+			"POST",
+			"/series",
+			"Title required.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:id/posts with a post of another author should return error", func() {
+		otherCookies := createTestUserAndLogin(c, "test-series-other@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "not yours"}, otherCookies)
+		cookies := createTestUserAndLogin(c, "test-series-taker@test.com", "test-pwd")
+		seriesID := createSeriesWithAPI(c, Data{"title": "Borrowed"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			fmt.Sprintf("/series/%d/posts", seriesID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /:id/posts with a post in another series should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-series-twice@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "one series only"}, cookies)
+		firstID := createSeriesWithAPI(c, Data{"title": "First"}, cookies)
+		secondID := createSeriesWithAPI(c, Data{"title": "Second"}, cookies)
+		makeValidStatusReq(c, "POST", fmt.Sprintf("/series/%d/posts", firstID), Data{"post_id": postID}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_id": postID},
+			"POST",
+			fmt.Sprintf("/series/%d/posts", secondID),
+			"Post already belongs to a series.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("PUT /:id/posts on a series of another author should return error", func() {
+		ownerCookies := createTestUserAndLogin(c, "test-series-owner@test.com", "test-pwd")
+		seriesID := createSeriesWithAPI(c, Data{"title": "Mine"}, ownerCookies)
+		cookies := createTestUserAndLogin(c, "test-series-intruder@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"post_ids": []int{}},
+			"PUT",
+			fmt.Sprintf("/series/%d/posts", seriesID),
+			"User is not the author of the series.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func createShareLinkWithAPI(c *Container, postID int, values Data, cookies []*http.Cookie) (int, string) {
+	response := makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/shares", postID), values, cookies)
+	return int(response["id"].(float64)), response["token"].(string)
+}
+
+// testShares tests share links to unpublished posts and /shared/:token
+func testShares(c *Container) {
+	var cookies []*http.Cookie
+	var postID, linkID int
+	var token string
+
+	c.Goblin.Before(func() {
+		cookies = createTestUserAndLogin(c, "test-share@test.com", "test-pwd")
+		postID = createPostWithAPI(c, Data{"title": "Shared draft", "doc": "Feedback welcome", "publish_at": "2100-04-01T09:00:00Z"}, cookies)
+		linkID, token = createShareLinkWithAPI(c, postID, Data{"allow_comments": true}, cookies)
+	})
+
+	c.Goblin.It("GET /shared/:token should return the draft without an account", func() {
+		response := makeValidReq(c, "GET", "/shared/"+token, nil, nil)
+		c.Goblin.Assert(response["id"]).Eql(float64(postID))
+		c.Goblin.Assert(response["doc"]).Eql("Feedback welcome")
+		c.Goblin.Assert(response["allow_comments"]).IsTrue()
+	})
+
+	c.Goblin.It("GET /posts should not list the shared draft", func() {
+		response := makeValidReq(c, "GET", "/posts", nil, nil)
+		for _, id := range searchResultIDs(response) {
+			c.Goblin.Assert(id == postID).IsFalse()
+		}
+	})
+
+	c.Goblin.It("POST /shared/:token/comments should leave feedback", func() {
+		response := makeValidReq(c, "POST", "/shared/"+token+"/comments", Data{"name": "Reviewer", "body": "Nice start"}, nil)
+		c.Goblin.Assert(response["name"]).Eql("Reviewer")
+
+		comments := makeValidReq(c, "GET", "/shared/"+token, nil, nil)["comments"].([]interface{})
+		c.Goblin.Assert(len(comments)).Eql(1)
+		c.Goblin.Assert(comments[0].(map[string]interface{})["body"]).Eql("Nice start")
+	})
+
+	c.Goblin.It("GET /posts/:id/shares should count the accesses of each link", func() {
+		links := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/shares", postID), nil, cookies)["links"].([]interface{})
+		c.Goblin.Assert(len(links)).Eql(1)
+		link := links[0].(map[string]interface{})
+		c.Goblin.Assert(link["access_count"]).Eql(float64(2))
+		_, hasToken := link["token"]
+		c.Goblin.Assert(hasToken).IsFalse()
+
+		log := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/shares/%d", postID, linkID), nil, cookies)
+		c.Goblin.Assert(len(log["accesses"].([]interface{}))).Eql(2)
+		c.Goblin.Assert(len(log["comments"].([]interface{}))).Eql(1)
+	})
+
+	c.Goblin.It("DELETE /posts/:id/shares/:share_id should revoke the link", func() {
+		makeValidStatusReq(c, "DELETE", fmt.Sprintf("/posts/%d/shares/%d", postID, linkID), nil, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/shared/" + token,
+			"Share link not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	testSharesWithInvalidData(c)
+}
+
+// RunSharesTests executes all tests for share links
+func RunSharesTests(c *Container) {
+	c.Goblin.Describe("API /shared", func() {
+		// GET, POST /posts/:id/shares, GET, DELETE /posts/:id/shares/:share_id,
+		// GET /shared/:token, POST /shared/:token/comments
+		testShares(c)
+	})
+}
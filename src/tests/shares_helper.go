@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+func testSharesWithInvalidData(c *Container) {
+	c.Goblin.It("POST /posts/:id/shares on a published post should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-share-published@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "already public"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{},
+			"POST",
+			fmt.Sprintf("/posts/%d/shares", postID),
+			"Post already published.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /posts/:id/shares by another user should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "test-share-owner@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "private", "publish_at": "2100-04-01T09:00:00Z"}, authorCookies)
+		cookies := createTestUserAndLogin(c, "test-share-stranger@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{},
+			"POST",
+			fmt.Sprintf("/posts/%d/shares", postID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST /shared/:token/comments in reply to a comment of another link should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-share-thread@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "threads", "publish_at": "2100-04-01T09:00:00Z"}, cookies)
+		_, otherToken := createShareLinkWithAPI(c, postID, Data{"allow_comments": true}, cookies)
+		_, token := createShareLinkWithAPI(c, postID, Data{"allow_comments": true}, cookies)
+		comment := makeValidReq(c, "POST", "/shared/"+otherToken+"/comments", Data{"name": "Reviewer", "body": "Elsewhere"}, nil)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"name": "Reviewer", "body": "Reply", "parent_id": comment["id"]},
+			"POST",
+			"/shared/" + token + "/comments",
+			"Comment not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /shared/:token after the expiry should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-share-expired@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "short lived", "publish_at": "2100-04-01T09:00:00Z"}, cookies)
+		linkID, token := createShareLinkWithAPI(c, postID, Data{"expires_at": "2100-01-01T00:00:00Z"}, cookies)
+		_, err := models.ShareLinks(qm.Where("id = ?", linkID)).UpdateAll(c.Context, c.DB, models.M{"expires_at": time.Now().Add(-time.Minute)})
+		c.Goblin.Assert(err).IsNil()
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/shared/" + token,
+			"Share link expired.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /shared/:token with an unknown token should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/shared/not-a-token",
+			"Share link not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("POST /shared/:token/comments on a read-only link should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-share-readonly@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "no comments", "publish_at": "2100-04-01T09:00:00Z"}, cookies)
+		_, token := createShareLinkWithAPI(c, postID, Data{}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"name": "Reviewer", "body": "Let me in"},
+			"POST",
+			"/shared/" + token + "/comments",
+			"Comments are not allowed.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/stream"
+)
+
+// streamTimeout is how long a test waits for an event
+const streamTimeout = 5 * time.Second
+
+// streamEvent is an event read from /stream
+type streamEvent struct {
+	id    string
+	event string
+	data  map[string]interface{}
+}
+
+// openStream subscribes to topics on a running server and returns the events it sends
+// along with a function closing the stream
+func openStream(c *Container, server *httptest.Server, topics string, lastEventID string, cookies []*http.Cookie) (<-chan streamEvent, func()) {
+	req, _ := http.NewRequest("GET", server.URL+"/api/v1/stream?topics="+topics, nil)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	res, err := server.Client().Do(req)
+	c.Goblin.Assert(err).IsNil()
+	c.Goblin.Assert(res.StatusCode).Eql(http.StatusOK)
+	c.Goblin.Assert(res.Header.Get("Content-Type")).Eql("text/event-stream")
+
+	events := make(chan streamEvent, 16)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(res.Body)
+		e := streamEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				e.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				e.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				_ = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e.data)
+			case line == "" && e.event != "":
+				events <- e
+				e = streamEvent{}
+			}
+		}
+	}()
+	return events, func() { res.Body.Close() }
+}
+
+// nextStreamEvent returns the next event of a stream
+func nextStreamEvent(c *Container, events <-chan streamEvent) streamEvent {
+	select {
+	case e, ok := <-events:
+		c.Goblin.Assert(ok).IsTrue()
+		return e
+	case <-time.After(streamTimeout):
+		c.Goblin.Fail("No event received.")
+		return streamEvent{}
+	}
+}
+
+// testStream tests real-time updates over /stream
+func testStream(c *Container) {
+	var server *httptest.Server
+	var authorCookies, readerCookies []*http.Cookie
+	var postID int
+
+	c.Goblin.Before(func() {
+		server = httptest.NewServer(c.Router)
+		authorCookies = createTestUserAndLogin(c, "stream-writer@test.com", "test-pwd")
+		readerCookies = createTestUserAndLogin(c, "stream-reader@test.com", "test-pwd")
+		postID = createPostWithAPI(c, Data{"title": "Live", "doc": "Watch the likes"}, authorCookies)
+	})
+
+	c.Goblin.After(func() {
+		server.Close()
+	})
+
+	c.Goblin.It("GET /stream should push the like count of a post", func() {
+		events, closeStream := openStream(c, server, fmt.Sprintf("post:%d:likes", postID), "", readerCookies)
+		defer closeStream()
+
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, readerCookies)
+		e := nextStreamEvent(c, events)
+		c.Goblin.Assert(e.event).Eql("likes")
+		c.Goblin.Assert(e.data["post_id"]).Eql(float64(postID))
+		c.Goblin.Assert(e.data["likes"]).Eql(float64(1))
+	})
+
+	c.Goblin.It("GET /stream should push the notifications of the current user", func() {
+		events, closeStream := openStream(c, server, "notifications", "", authorCookies)
+		defer closeStream()
+
+		followUserWithAPI(c, "stream-writer@test.com", readerCookies)
+		e := nextStreamEvent(c, events)
+		c.Goblin.Assert(e.event).Eql("notification")
+		c.Goblin.Assert(e.data["type"]).Eql("follow")
+		c.Goblin.Assert(e.data["actor_name"]).Eql("stream-reader")
+	})
+
+	c.Goblin.It("GET /stream should push the comments left on a post of the user", func() {
+		draftID := createPostWithAPI(c, Data{"doc": "Draft", "publish_at": "2100-07-01T09:00:00Z"}, authorCookies)
+		_, token := createShareLinkWithAPI(c, draftID, Data{"allow_comments": true}, authorCookies)
+		events, closeStream := openStream(c, server, fmt.Sprintf("post:%d:comments", draftID), "", authorCookies)
+		defer closeStream()
+
+		makeValidReq(c, "POST", "/shared/"+token+"/comments", Data{"name": "Reviewer", "body": "Live feedback"}, nil)
+		e := nextStreamEvent(c, events)
+		c.Goblin.Assert(e.event).Eql("comment")
+		c.Goblin.Assert(e.data["body"]).Eql("Live feedback")
+	})
+
+	c.Goblin.It("GET /stream with Last-Event-ID should replay the missed events", func() {
+		topic := fmt.Sprintf("post:%d:likes", postID)
+		events, closeStream := openStream(c, server, topic, "", readerCookies)
+		makeValidReq(c, "DELETE", fmt.Sprintf("/posts/%d/like", postID), nil, readerCookies)
+		last := nextStreamEvent(c, events)
+		closeStream()
+
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, readerCookies)
+		makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/like", postID), nil, authorCookies)
+
+		events, closeStream = openStream(c, server, topic, last.id, readerCookies)
+		defer closeStream()
+		c.Goblin.Assert(nextStreamEvent(c, events).data["likes"]).Eql(float64(1))
+		c.Goblin.Assert(nextStreamEvent(c, events).data["likes"]).Eql(float64(2))
+	})
+
+	c.Goblin.It("GET /stream with an event no longer buffered should send a reset", func() {
+		events, closeStream := openStream(c, server, "notifications", "1000000000", readerCookies)
+		defer closeStream()
+		c.Goblin.Assert(nextStreamEvent(c, events).event).Eql("reset")
+	})
+
+	c.Goblin.It("a subscriber falling behind should be dropped", func() {
+		broker := stream.NewBroker(8, 1)
+		sub, _, _ := broker.Subscribe([]string{"post:1:likes"}, 0)
+		broker.Publish(stream.Event{ID: 1, Topic: "post:1:likes", Type: stream.EventLikes})
+		broker.Publish(stream.Event{ID: 2, Topic: "post:1:likes", Type: stream.EventLikes})
+
+		e, ok := <-sub.Events()
+		c.Goblin.Assert(ok).IsTrue()
+		c.Goblin.Assert(e.ID).Eql(int64(1))
+		_, ok = <-sub.Events()
+		c.Goblin.Assert(ok).IsFalse()
+
+		_, replay, resumed := broker.Subscribe([]string{"post:1:likes"}, 1)
+		c.Goblin.Assert(resumed).IsTrue()
+		c.Goblin.Assert(len(replay)).Eql(1)
+	})
+}
+
+// RunStreamTests executes tests for /stream
+func RunStreamTests(c *Container) {
+	c.Goblin.Describe("Stream endpoint test", func() {
+		testStream(c)
+		testStreamWithInvalidData(c)
+	})
+}
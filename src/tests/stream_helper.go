@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+func testStreamWithInvalidData(c *Container) {
+	c.Goblin.It("GET /stream without a login should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/stream?topics=notifications",
+			"Token not found.",
+			http.StatusUnauthorized,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /stream with an unknown topic should return error", func() {
+		cookies := createTestUserAndLogin(c, "stream-topic@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/stream?topics=everything",
+			"Invalid topic.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("GET /stream with the comments of a post of another user should return error", func() {
+		authorCookies := createTestUserAndLogin(c, "stream-owner@test.com", "test-pwd")
+		cookies := createTestUserAndLogin(c, "stream-other@test.com", "test-pwd")
+		postID := createPostWithAPI(c, Data{"doc": "Mine"}, authorCookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/stream?topics=post:%d:comments", postID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("GET /stream with an invalid Last-Event-ID should return error", func() {
+		cookies := createTestUserAndLogin(c, "stream-resume@test.com", "test-pwd")
+		req, _ := http.NewRequest("GET", "/api/v1/stream?topics=notifications", nil)
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+		req.Header.Set("Last-Event-ID", "latest")
+
+		result := httptest.NewRecorder()
+		c.Router.ServeHTTP(result, req)
+		c.Goblin.Assert(result.Code).Eql(http.StatusBadRequest)
+		c.Goblin.Assert(extractBody(result)["message"]).Eql("Invalid Last-Event-ID.")
+	})
+}
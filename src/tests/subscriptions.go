@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const membersOnlyDoc = "First paragraph.\n\nSecond paragraph.\n\nThird paragraph.\n\nFourth paragraph.\n\nFifth paragraph."
+
+func createMembersOnlyPost(c *Container, title string, cookies []*http.Cookie) int {
+	return createPostWithAPI(c, Data{"title": title, "doc": membersOnlyDoc, "members_only": true}, cookies)
+}
+
+func readPost(c *Container, postID int, cookies []*http.Cookie) map[string]interface{} {
+	return makeValidReq(c, "GET", fmt.Sprintf("/posts/%d", postID), nil, cookies)
+}
+
+// testSubscriptions tests members-only posts behind the paywall and /subscriptions
+func testSubscriptions(c *Container) {
+	var authorCookies, readerCookies []*http.Cookie
+	var postID int
+
+	c.Goblin.Before(func() {
+		authorCookies = createTestUserAndLogin(c, "test-paywall-author@test.com", "test-pwd")
+		readerCookies = createTestUserAndLogin(c, "test-paywall-reader@test.com", "test-pwd")
+		postID = createMembersOnlyPost(c, "Members only", authorCookies)
+	})
+
+	c.Goblin.It("GET /posts/:id should show the whole post to its author", func() {
+		response := readPost(c, postID, authorCookies)
+		c.Goblin.Assert(response["members_only"]).IsTrue()
+		c.Goblin.Assert(response["paywall"]).IsFalse()
+		c.Goblin.Assert(response["doc"]).Eql(membersOnlyDoc)
+	})
+
+	c.Goblin.It("GET /posts/:id should show a preview to anonymous readers", func() {
+		response := readPost(c, postID, nil)
+		c.Goblin.Assert(response["paywall"]).IsTrue()
+		c.Goblin.Assert(response["doc"]).Eql("First paragraph.\n\nSecond paragraph.\n\nThird paragraph.")
+
+		rendered := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d?format=html", postID), nil, nil)
+		c.Goblin.Assert(strings.Contains(rendered["html"].(string), "Third paragraph")).IsTrue()
+		c.Goblin.Assert(strings.Contains(rendered["html"].(string), "Fifth paragraph")).IsFalse()
+	})
+
+	c.Goblin.It("GET /posts/:id should meter members-only posts for readers without a subscription", func() {
+		allowance := c.Env.FreeArticlesPerMonth
+		response := readPost(c, postID, readerCookies)
+		c.Goblin.Assert(response["paywall"]).IsFalse()
+		c.Goblin.Assert(response["metered"].(map[string]interface{})["remaining"]).Eql(float64(allowance - 1))
+
+		// Reading a post again in the same month is free
+		response = readPost(c, postID, readerCookies)
+		c.Goblin.Assert(response["metered"].(map[string]interface{})["remaining"]).Eql(float64(allowance - 1))
+
+		for i := 1; i < allowance; i++ {
+			response = readPost(c, createMembersOnlyPost(c, fmt.Sprintf("Metered %d", i), authorCookies), readerCookies)
+			c.Goblin.Assert(response["paywall"]).IsFalse()
+		}
+
+		response = readPost(c, createMembersOnlyPost(c, "Over the limit", authorCookies), readerCookies)
+		c.Goblin.Assert(response["paywall"]).IsTrue()
+		c.Goblin.Assert(response["metered"].(map[string]interface{})["remaining"]).Eql(float64(0))
+		c.Goblin.Assert(readPost(c, postID, readerCookies)["paywall"]).IsFalse()
+	})
+
+	c.Goblin.It("POST /subscriptions should unlock every members-only post", func() {
+		response := makeValidReq(c, "POST", "/subscriptions", Data{"tier": "member", "period": "month", "token": "tok_visa"}, readerCookies)
+		c.Goblin.Assert(response["status"]).Eql("active")
+
+		response = readPost(c, createMembersOnlyPost(c, "For subscribers", authorCookies), readerCookies)
+		c.Goblin.Assert(response["paywall"]).IsFalse()
+		_, metered := response["metered"]
+		c.Goblin.Assert(metered).IsFalse()
+	})
+
+	c.Goblin.It("DELETE /subscriptions/me should keep access until the end of the period", func() {
+		response := makeValidReq(c, "DELETE", "/subscriptions/me", nil, readerCookies)
+		c.Goblin.Assert(response["status"]).Eql("canceled")
+		c.Goblin.Assert(response["canceled_at"]).IsNotNil()
+
+		response = readPost(c, createMembersOnlyPost(c, "After canceling", authorCookies), readerCookies)
+		c.Goblin.Assert(response["paywall"]).IsFalse()
+		c.Goblin.Assert(makeValidReq(c, "GET", "/subscriptions/me", nil, readerCookies)["status"]).Eql("canceled")
+	})
+
+	c.Goblin.It("revisions, search and listings should only show a preview to anonymous readers", func() {
+		revisions := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/revisions", postID), nil, nil)
+		c.Goblin.Assert(revisions["paywall"]).IsTrue()
+		first := revisions["revisions"].([]interface{})[0].(map[string]interface{})
+		c.Goblin.Assert(first["doc"]).Eql("First paragraph.\n\nSecond paragraph.\n\nThird paragraph.")
+
+		revision := makeValidReq(c, "GET", fmt.Sprintf("/posts/%d/revisions/1", postID), nil, nil)
+		c.Goblin.Assert(revision["paywall"]).IsTrue()
+		c.Goblin.Assert(strings.Contains(revision["doc"].(string), "Fifth")).IsFalse()
+
+		found := searchWithAPI(c, "q=fifth+paragraph&author=test-paywall-author")
+		for _, p := range found["posts"].([]interface{}) {
+			c.Goblin.Assert(strings.Contains(p.(map[string]interface{})["snippet"].(string), "Fifth")).IsFalse()
+		}
+
+		listed := makeValidReq(c, "GET", "/posts?author=test-paywall-author", nil, nil)
+		for _, p := range listed["posts"].([]interface{}) {
+			c.Goblin.Assert(strings.Contains(p.(map[string]interface{})["excerpt"].(string), "Fifth")).IsFalse()
+		}
+	})
+
+	c.Goblin.It("PUT /posts should open a members-only post to everyone", func() {
+		response := updatePostWithAPI(c, Data{"id": postID, "members_only": false}, authorCookies)
+		c.Goblin.Assert(response["members_only"]).IsFalse()
+		c.Goblin.Assert(readPost(c, postID, nil)["doc"]).Eql(membersOnlyDoc)
+	})
+
+	testSubscriptionsWithInvalidData(c)
+}
+
+// RunSubscriptionsTests executes all tests for the paywall and /subscriptions
+func RunSubscriptionsTests(c *Container) {
+	c.Goblin.Describe("API /subscriptions", func() {
+		// GET /posts/:id of members-only posts, POST /subscriptions, GET, DELETE /subscriptions/me
+		testSubscriptions(c)
+	})
+}
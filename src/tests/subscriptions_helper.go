@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"net/http"
+)
+
+func testSubscriptionsWithInvalidData(c *Container) {
+	c.Goblin.It("POST with an invalid tier should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-paywall-tier@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"tier": "gold", "period": "month", "token": "tok_visa"},
+			"POST",
+			"/subscriptions",
+			"Invalid tier.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with an invalid period should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-paywall-period@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"tier": "member", "period": "week", "token": "tok_visa"},
+			"POST",
+			"/subscriptions",
+			"Invalid period.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST with a declined payment should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-paywall-declined@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"tier": "patron", "period": "year", "token": "tok_declined"},
+			"POST",
+			"/subscriptions",
+			"Payment declined.",
+			http.StatusPaymentRequired,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("POST while subscribed should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-paywall-twice@test.com", "test-pwd")
+		makeValidReq(c, "POST", "/subscriptions", Data{"tier": "member", "period": "year", "token": "tok_visa"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"tier": "patron", "period": "year", "token": "tok_visa"},
+			"POST",
+			"/subscriptions",
+			"Already subscribed.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("DELETE /me without a subscription should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-paywall-none@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			"/subscriptions/me",
+			"Subscription not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/blocks"
+	"github.com/json9512/mediumclone-backendwithgo/src/summary"
+)
+
+// testPostSummary tests the summary stored and returned for posts
+func testPostSummary(c *Container) {
+	c.Goblin.It("POST should store the word count, reading time and excerpt", func() {
+		cookies := createTestUserAndLogin(c, "test-summary-create@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "summary", "doc": "# Title\n\nFirst sentence. Second *one*!"}, cookies)
+
+		post := getPostFromDBByID(c, id)
+		c.Goblin.Assert(post.WordCount.Int).Eql(5)
+		c.Goblin.Assert(post.ReadingTime.Int).Eql(1)
+		c.Goblin.Assert(post.Excerpt.String).Eql("First sentence. Second one!")
+	})
+
+	c.Goblin.It("PUT should update the summary", func() {
+		cookies := createTestUserAndLogin(c, "test-summary-update@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"title": "summary", "doc": "Before."}, cookies)
+		updatePostWithAPI(c, Data{"id": id, "doc": "After the update."}, cookies)
+
+		post := getPostFromDBByID(c, id)
+		c.Goblin.Assert(post.WordCount.Int).Eql(3)
+		c.Goblin.Assert(post.Excerpt.String).Eql("After the update.")
+	})
+
+	c.Goblin.It("GET should return excerpts instead of documents", func() {
+		cookies := createTestUserAndLogin(c, "test-summary-list@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"title": "summary", "doc": "Listed post. " + strings.Repeat("word ", 300)}, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    "/posts?author=test-summary-list",
+			reqBody: nil,
+			cookie:  nil,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+
+		posts := response["posts"].([]interface{})
+		c.Goblin.Assert(len(posts)).Eql(1)
+		post := posts[0].(map[string]interface{})
+		_, docExists := post["doc"]
+		c.Goblin.Assert(docExists).IsFalse()
+		c.Goblin.Assert(post["excerpt"]).Eql("Listed post.")
+		c.Goblin.Assert(post["word_count"]).Eql(float64(302))
+		c.Goblin.Assert(post["reading_time"]).Eql(float64(2))
+	})
+}
+
+// testSummarize tests word counts, reading times and excerpts of documents
+func testSummarize(c *Container) {
+	c.Goblin.It("Summarize should count Chinese and Japanese characters as words", func() {
+		s := summary.Summarize(blocks.FromMarkdown("这是一个测试。Hello world"))
+		c.Goblin.Assert(s.WordCount).Eql(8)
+	})
+
+	c.Goblin.It("Summarize should count Korean words by spaces", func() {
+		s := summary.Summarize(blocks.FromMarkdown("안녕하세요 반갑습니다"))
+		c.Goblin.Assert(s.WordCount).Eql(2)
+	})
+
+	c.Goblin.It("Summarize should add time for images", func() {
+		doc := &blocks.Document{Version: blocks.Version}
+		for i := 0; i < 10; i++ {
+			doc.Blocks = append(doc.Blocks, &blocks.Block{Type: blocks.Image, URL: "https://example.com/a.png"})
+		}
+		// 12 + 11 + ... + 3 seconds
+		c.Goblin.Assert(summary.Summarize(doc).ReadingTime).Eql(2)
+	})
+
+	c.Goblin.It("Excerpt should end at a sentence boundary", func() {
+		doc := blocks.FromMarkdown("# Title\n\nShort one. " + strings.Repeat("long ", 10) + "end.\n\n> 第一句。第二句。")
+		c.Goblin.Assert(summary.Excerpt(doc, 20)).Eql("Short one.")
+		c.Goblin.Assert(summary.Excerpt(blocks.FromMarkdown("第一句。第二句。"), 20)).Eql("第一句。第二句。")
+	})
+
+	c.Goblin.It("Excerpt should cut a long first sentence at a word", func() {
+		doc := blocks.FromMarkdown("A single sentence that goes on without a stop")
+		c.Goblin.Assert(summary.Excerpt(doc, 20)).Eql("A single sentence…")
+	})
+}
+
+// RunSummaryTests executes all tests for post summaries
+func RunSummaryTests(c *Container) {
+	c.Goblin.Describe("Post summaries", func() {
+		// POST, PUT and GET /posts
+		testPostSummary(c)
+
+		// summary.Summarize and summary.Excerpt
+		testSummarize(c)
+	})
+}
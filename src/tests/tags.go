@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+func findTagInResponse(tags interface{}, slug string) map[string]interface{} {
+	for _, t := range tags.([]interface{}) {
+		tag := t.(map[string]interface{})
+		if tag["slug"] == slug {
+			return tag
+		}
+	}
+	return nil
+}
+
+// testNormalizeTags tests that posts are saved with canonical tags
+func testNormalizeTags(c *Container) {
+	c.Goblin.It("POST should trim, case fold and dedupe tags", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-normalize@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"doc": "text", "tags": " Gardening , gardening,  Raised   Beds ,,"}, cookies)
+
+		post := getPostFromDBByID(c, id)
+		c.Goblin.Assert([]string(post.Tags)).Eql([]string{"gardening", "raised-beds"})
+
+		postTags, err := models.PostTags(qm.Where("post_id = ?", id), qm.OrderBy("position")).All(c.Context, c.DB)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(len(postTags)).Eql(2)
+
+		tag, err := models.Tags(qm.Where("slug = ?", "raised-beds")).One(c.Context, c.DB)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(tag.Name).Eql("Raised Beds")
+	})
+
+	c.Goblin.It("POST without tags should save no tags", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-empty@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"doc": "text", "tags": ""}, cookies)
+
+		post := getPostFromDBByID(c, id)
+		c.Goblin.Assert(len(post.Tags)).Eql(0)
+	})
+
+	testCreatePostWithTooManyTags(c)
+}
+
+// testGetTags tests /tags to list tags and their posts
+func testGetTags(c *Container) {
+	c.Goblin.It("GET should return tags with post counts", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-list@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"doc": "text", "tags": "composting"}, cookies)
+		createPostWithAPI(c, Data{"doc": "text", "tags": "composting,worms"}, cookies)
+
+		response := makeValidReq(c, "GET", "/tags?per_page=100", nil, nil)
+		composting := findTagInResponse(response["tags"], "composting")
+		c.Goblin.Assert(composting).IsNotNil()
+		c.Goblin.Assert(composting["post_count"]).Eql(float64(2))
+
+		response = makeValidReq(c, "GET", "/tags?per_page=1", nil, nil)
+		c.Goblin.Assert(len(response["tags"].([]interface{}))).Eql(1)
+		c.Goblin.Assert(response["total_count"].(float64) >= 2).IsTrue()
+	})
+
+	c.Goblin.It("/:slug GET should return the tag and its posts", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-get@test.com", "test-pwd")
+		id := createPostWithAPI(c, Data{"doc": "text", "tags": "Seed Saving"}, cookies)
+
+		response := makeValidReq(c, "GET", "/tags/seed-saving", nil, nil)
+		c.Goblin.Assert(response["name"]).Eql("Seed Saving")
+		c.Goblin.Assert(response["post_count"]).Eql(float64(1))
+
+		posts := response["posts"].([]interface{})
+		c.Goblin.Assert(posts[0].(map[string]interface{})["id"]).Eql(float64(id))
+	})
+
+	testGetTagWithInvalidSlug(c)
+}
+
+// testFollowTags tests following and unfollowing tags
+func testFollowTags(c *Container) {
+	c.Goblin.It("/:slug/follow POST and DELETE should follow and unfollow a tag", func() {
+		email := "test-tags-follow@test.com"
+		cookies := createTestUserAndLogin(c, email, "test-pwd")
+		createPostWithAPI(c, Data{"doc": "text", "tags": "mulching"}, cookies)
+		user := getUserFromDBByEmail(c, email)
+
+		response := makeValidReq(c, "POST", "/tags/mulching/follow", nil, cookies)
+		c.Goblin.Assert(response["following"]).IsTrue()
+		makeValidReq(c, "POST", "/tags/mulching/follow", nil, cookies)
+
+		response = makeValidReq(c, "GET", fmt.Sprintf("/users/%d/tags", user.ID), nil, nil)
+		c.Goblin.Assert(response["total_count"]).Eql(float64(1))
+		c.Goblin.Assert(findTagInResponse(response["tags"], "mulching")).IsNotNil()
+
+		response = makeValidReq(c, "GET", "/tags/mulching", nil, nil)
+		c.Goblin.Assert(response["follower_count"]).Eql(float64(1))
+
+		makeValidReq(c, "DELETE", "/tags/mulching/follow", nil, cookies)
+		response = makeValidReq(c, "GET", fmt.Sprintf("/users/%d/tags", user.ID), nil, nil)
+		c.Goblin.Assert(response["total_count"]).Eql(float64(0))
+	})
+}
+
+// testTagAliases tests aliases managed by admins
+func testTagAliases(c *Container) {
+	c.Goblin.It("/:slug/aliases POST should merge the tag with the alias slug", func() {
+		email := "test-tags-alias@test.com"
+		cookies := createTestUserAndLogin(c, email, "test-pwd")
+		makeAdmin(c, email)
+		canonicalID := createPostWithAPI(c, Data{"doc": "text", "tags": "tomatoes"}, cookies)
+		aliasedID := createPostWithAPI(c, Data{"doc": "text", "tags": "tomato,tomatoes"}, cookies)
+		makeValidReq(c, "POST", "/tags/tomato/follow", nil, cookies)
+
+		response := makeValidReq(c, "POST", "/tags/tomatoes/aliases", Data{"alias": "Tomato"}, cookies)
+		c.Goblin.Assert(response["alias"]).Eql("tomato")
+
+		response = makeValidReq(c, "GET", "/tags/tomato", nil, nil)
+		c.Goblin.Assert(response["slug"]).Eql("tomatoes")
+		c.Goblin.Assert(response["post_count"]).Eql(float64(2))
+		c.Goblin.Assert(response["follower_count"]).Eql(float64(1))
+		c.Goblin.Assert(response["aliases"]).Eql([]interface{}{"tomato"})
+
+		c.Goblin.Assert([]string(getPostFromDBByID(c, aliasedID).Tags)).Eql([]string{"tomatoes"})
+		c.Goblin.Assert([]string(getPostFromDBByID(c, canonicalID).Tags)).Eql([]string{"tomatoes"})
+
+		id := createPostWithAPI(c, Data{"doc": "text", "tags": "TOMATO"}, cookies)
+		c.Goblin.Assert([]string(getPostFromDBByID(c, id).Tags)).Eql([]string{"tomatoes"})
+
+		response = makeValidReq(c, "GET", "/posts?tags=Tomato&author=test-tags-alias", nil, nil)
+		c.Goblin.Assert(response["total_count"]).Eql(float64(3))
+		response = searchWithAPI(c, "q=text&tags=tomato&author=test-tags-alias")
+		c.Goblin.Assert(response["total_count"]).Eql(float64(3))
+	})
+
+	c.Goblin.It("/:slug/aliases/:alias DELETE should remove the alias", func() {
+		email := "test-tags-unalias@test.com"
+		cookies := createTestUserAndLogin(c, email, "test-pwd")
+		makeAdmin(c, email)
+		createPostWithAPI(c, Data{"doc": "text", "tags": "peppers"}, cookies)
+
+		makeValidReq(c, "POST", "/tags/peppers/aliases", Data{"alias": "capsicum"}, cookies)
+		makeValidReq(c, "DELETE", "/tags/peppers/aliases/capsicum", nil, cookies)
+
+		response := makeValidReq(c, "GET", "/tags/peppers", nil, nil)
+		c.Goblin.Assert(response["aliases"]).Eql([]interface{}{})
+	})
+
+	testAddTagAliasWithoutAdmin(c)
+}
+
+// RunTagsTests executes all tests for /tags
+func RunTagsTests(c *Container) {
+	c.Goblin.Describe("API /tags", func() {
+		// POST /posts with tags
+		testNormalizeTags(c)
+
+		// GET /tags, GET /tags/:slug
+		testGetTags(c)
+
+		// POST, DELETE /tags/:slug/follow, GET /users/:id/tags
+		testFollowTags(c)
+
+		// POST /tags/:slug/aliases, DELETE /tags/:slug/aliases/:alias
+		testTagAliases(c)
+	})
+}
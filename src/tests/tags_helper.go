@@ -0,0 +1,47 @@
+package tests
+
+import "net/http"
+
+func testCreatePostWithTooManyTags(c *Container) {
+	c.Goblin.It("POST with too many tags should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-too-many@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"doc": "text", "tags": "a,b,c,d,e,f"},
+			"POST",
+			"/posts",
+			"Too many tags.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
+
+func testGetTagWithInvalidSlug(c *Container) {
+	c.Goblin.It("/:slug GET with an unknown slug should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/tags/no-such-tag",
+			"Tag not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
+
+func testAddTagAliasWithoutAdmin(c *Container) {
+	c.Goblin.It("/:slug/aliases POST by a user who is not an admin should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-tags-not-admin@test.com", "test-pwd")
+		createPostWithAPI(c, Data{"doc": "text", "tags": "onions"}, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			Data{"alias": "onion"},
+			"POST",
+			"/tags/onions/aliases",
+			"User is not an admin.",
+			http.StatusForbidden,
+			cookies,
+		})
+	})
+}
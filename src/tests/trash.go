@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+)
+
+// testDeletePostMovesToTrash tests that /posts/:id DELETE keeps the post in the trash
+func testDeletePostMovesToTrash(c *Container) {
+	c.Goblin.It("/:id DELETE should hide the post and list it in the trash", func() {
+		u := userInfo{"test-trash-post@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "trash me"}, &u)
+		deletePostWithAPI(c, post.ID, cookies)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			fmt.Sprintf("/posts/%d", post.ID),
+			"Post not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "GET",
+			path:    "/users/me/trash",
+			reqBody: nil,
+			cookie:  cookies,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		response, err := extractResult(result)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(response["total_count"]).Eql(float64(1))
+	})
+
+	c.Goblin.It("PUT should not move the post to the trash", func() {
+		u := userInfo{"test-trash-update@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "keep me"}, &u)
+		updatePostWithAPI(c, Data{"id": post.ID, "doc": "still here"}, cookies)
+
+		updated := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(updated.DeletedAt.Valid).IsFalse()
+	})
+
+	testGetTrashOfOtherUser(c)
+}
+
+// testRestorePost tests /posts/:id/restore to take a post out of the trash
+func testRestorePost(c *Container) {
+	c.Goblin.It("/:id/restore POST should take the post out of the trash", func() {
+		u := userInfo{"test-restore-post@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "bring me back"}, &u)
+		deletePostWithAPI(c, post.ID, cookies)
+
+		result := MakeRequest(&reqData{
+			handler: c.Router,
+			method:  "POST",
+			path:    fmt.Sprintf("/posts/%d/restore", post.ID),
+			reqBody: nil,
+			cookie:  cookies,
+		})
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+		restored := getPostFromDBByID(c, post.ID)
+		c.Goblin.Assert(restored.DeletedAt.Valid).IsFalse()
+	})
+
+	testRestorePostNotInTrash(c)
+
+	testRestorePostWithInvalidUser(c)
+}
+
+// testPurgeDeletedPosts tests the background job removing old posts from the trash
+func testPurgeDeletedPosts(c *Container) {
+	c.Goblin.It("purge job should only remove posts deleted before the retention period", func() {
+		u := userInfo{"test-purge-post@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "purge me"}, &u)
+		deletePostWithAPI(c, post.ID, cookies)
+
+		deleted, err := findPostInDB(c, post.ID)
+		c.Goblin.Assert(err).IsNil()
+		deletedAt := deleted.DeletedAt.Time
+		retention := 30 * 24 * time.Hour
+		job := jobs.PurgeDeletedPosts(c.DB, retention)
+
+		err = job.Run(c.Context, deletedAt.Add(retention).Add(-time.Minute))
+		c.Goblin.Assert(err).IsNil()
+		_, err = findPostInDB(c, post.ID)
+		c.Goblin.Assert(err).IsNil()
+
+		err = job.Run(c.Context, deletedAt.Add(retention).Add(time.Minute))
+		c.Goblin.Assert(err).IsNil()
+		_, err = findPostInDB(c, post.ID)
+		c.Goblin.Assert(err).IsNotNil()
+	})
+}
+
+// RunTrashTests executes all tests for soft deleted posts
+func RunTrashTests(c *Container) {
+	c.Goblin.Describe("API /users/me/trash", func() {
+		// DELETE /posts/:id and GET /users/me/trash
+		testDeletePostMovesToTrash(c)
+
+		// POST /posts/:id/restore
+		testRestorePost(c)
+
+		// Background purge job
+		testPurgeDeletedPosts(c)
+	})
+}
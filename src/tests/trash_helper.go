@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+)
+
+func testGetTrashOfOtherUser(c *Container) {
+	c.Goblin.It("/:id/trash GET for another user should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-trash-other@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/users/1/trash",
+			"Invalid ID.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
+
+func testRestorePostNotInTrash(c *Container) {
+	c.Goblin.It("/:id/restore POST on a post not in the trash should return error", func() {
+		u := userInfo{"test-restore-post2@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "not deleted"}, &u)
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/restore", post.ID),
+			"Post not found in trash.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
+
+func testRestorePostWithInvalidUser(c *Container) {
+	c.Goblin.It("/:id/restore POST with invalid user should return error", func() {
+		u := userInfo{"test-restore-post3@test.com", "test-pwd", ""}
+		post, cookies, _ := loginAndCreatePost(c, &db.Post{Doc: "deleted"}, &u)
+		deletePostWithAPI(c, post.ID, cookies)
+		otherCookies := createTestUserAndLogin(c, "test-restore-post4@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/posts/%d/restore", post.ID),
+			"User is not the author of the post.",
+			http.StatusBadRequest,
+			otherCookies,
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// trendingAt is the fixed time rankings are computed at.
+// Posts created by other tests are published after it and never rank
+var trendingAt = time.Date(2020, 6, 15, 12, 0, 0, 0, time.UTC)
+
+func publishPostAt(c *Container, id int, publishedAt time.Time) {
+	_, err := models.Posts(qm.Where("id = ?", id)).UpdateAll(c.Context, c.DB, models.M{"published_at": publishedAt})
+	c.Goblin.Assert(err).IsNil()
+}
+
+// likePostAt adds likes of a post by new users at a time
+func likePostAt(c *Container, id int, likes int, at time.Time) {
+	for i := 0; i < likes; i++ {
+		user := &models.User{Email: null.StringFrom(fmt.Sprintf("test-trending-liker-%d-%d-%d@test.com", id, at.Unix(), i))}
+		c.Goblin.Assert(user.Insert(c.Context, c.DB, boil.Infer())).IsNil()
+		like := &models.PostLike{UserID: user.ID, PostID: id, CreatedAt: at}
+		c.Goblin.Assert(like.Insert(c.Context, c.DB, boil.Infer())).IsNil()
+	}
+}
+
+func trendingPostIDs(c *Container, query string) []int {
+	response := makeValidReq(c, "GET", "/posts/trending?"+query, nil, nil)
+	return searchResultIDs(response)
+}
+
+// testGetTrendingPosts tests /posts/trending to rank posts by recent activity
+func testGetTrendingPosts(c *Container) {
+	var fresh, popular, viewed int
+
+	c.Goblin.Before(func() {
+		cookies := createTestUserAndLogin(c, "test-trending@test.com", "test-pwd")
+		fresh = createPostWithAPI(c, Data{"title": "Fresh", "doc": "new", "tags": "hot"}, cookies)
+		popular = createPostWithAPI(c, Data{"title": "Popular", "doc": "liked"}, cookies)
+		viewed = createPostWithAPI(c, Data{"title": "Viewed", "doc": "read", "tags": "hot"}, cookies)
+
+		publishPostAt(c, fresh, trendingAt.Add(-time.Hour))
+		publishPostAt(c, popular, trendingAt.Add(-72*time.Hour))
+		publishPostAt(c, viewed, trendingAt.Add(-48*time.Hour))
+
+		likePostAt(c, fresh, 10, trendingAt.Add(-time.Hour))
+		likePostAt(c, popular, 50, trendingAt.Add(-time.Hour))
+		// Only counts in the rankings of the month
+		likePostAt(c, viewed, 1, trendingAt.AddDate(0, 0, -10))
+
+		views := &models.PostView{PostID: viewed, Day: trendingAt.AddDate(0, 0, -1), Views: 100}
+		c.Goblin.Assert(views.Insert(c.Context, c.DB, boil.Infer())).IsNil()
+
+		c.Goblin.Assert(db.RecomputeTrending(c.Context, c.DB, trendingAt)).IsNil()
+	})
+
+	c.Goblin.It("GET should rank posts by likes and views decayed by when they happened", func() {
+		response := makeValidReq(c, "GET", "/posts/trending", nil, nil)
+		c.Goblin.Assert(response["window"]).Eql("week")
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{popular, fresh, viewed})
+
+		first := response["posts"].([]interface{})[0].(map[string]interface{})
+		c.Goblin.Assert(first["rank"]).Eql(float64(1))
+		// The likes were given an hour ago, long after the post was published
+		c.Goblin.Assert(math.Abs(first["score"].(float64)-50*math.Pow(2, -1.0/36)) < 1e-9).IsTrue()
+	})
+
+	c.Goblin.It("GET should only count likes given within the window", func() {
+		response := makeValidReq(c, "GET", "/posts/trending", nil, nil)
+		last := response["posts"].([]interface{})[2].(map[string]interface{})
+		// 100 views a day and a half ago, at the start of their day
+		c.Goblin.Assert(math.Abs(last["score"].(float64)-10*math.Pow(2, -36.0/36)) < 1e-9).IsTrue()
+	})
+
+	c.Goblin.It("GET should only rank posts published within the window", func() {
+		c.Goblin.Assert(trendingPostIDs(c, "window=day")).Eql([]int{fresh})
+		c.Goblin.Assert(trendingPostIDs(c, "window=month")).Eql([]int{popular, fresh, viewed})
+	})
+
+	c.Goblin.It("GET should rank the posts with a tag", func() {
+		response := makeValidReq(c, "GET", "/posts/trending?tag=Hot", nil, nil)
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{fresh, viewed})
+
+		second := response["posts"].([]interface{})[1].(map[string]interface{})
+		c.Goblin.Assert(second["rank"]).Eql(float64(2))
+	})
+
+	c.Goblin.It("GET should paginate the ranking", func() {
+		response := makeValidReq(c, "GET", "/posts/trending?page=2&per_page=2", nil, nil)
+		c.Goblin.Assert(searchResultIDs(response)).Eql([]int{viewed})
+
+		last := response["posts"].([]interface{})[0].(map[string]interface{})
+		c.Goblin.Assert(last["rank"]).Eql(float64(3))
+	})
+
+	c.Goblin.It("GET /posts/:id should count a view of the post", func() {
+		makeValidReq(c, "GET", "/posts/"+strconv.Itoa(fresh), nil, nil)
+		makeValidReq(c, "GET", "/posts/"+strconv.Itoa(fresh), nil, nil)
+
+		views, err := models.PostViews(qm.Where("post_id = ?", fresh)).All(c.Context, c.DB)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(len(views)).Eql(1)
+		c.Goblin.Assert(views[0].Views).Eql(2)
+	})
+
+	testGetTrendingPostsWithInvalidQuery(c)
+}
+
+// RunTrendingTests executes all tests for /posts/trending
+func RunTrendingTests(c *Container) {
+	c.Goblin.Describe("API /posts/trending", func() {
+		// GET /posts/trending
+		testGetTrendingPosts(c)
+	})
+}
@@ -0,0 +1,27 @@
+package tests
+
+import "net/http"
+
+func testGetTrendingPostsWithInvalidQuery(c *Container) {
+	c.Goblin.It("GET with an unknown window should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/posts/trending?window=year",
+			"Invalid window.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET with an invalid page should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/posts/trending?page=0",
+			"Invalid page.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+}
@@ -0,0 +1,416 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/db"
+	"github.com/json9512/mediumclone-backendwithgo/src/jobs"
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
+)
+
+// testPNG returns a small PNG image whose content depends on the shade
+func testPNG(c *Container, shade uint8) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 4; y++ {
+			img.Set(x, y, color.RGBA{shade, shade, shade, 255})
+		}
+	}
+	var buf bytes.Buffer
+	c.Goblin.Assert(png.Encode(&buf, img)).IsNil()
+	return buf.Bytes()
+}
+
+// testJPEG returns a JPEG image of a gradient, with an EXIF orientation when it is above 1
+func testJPEG(c *Container, width, height, orientation int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, color.RGBA{uint8(x * 255 / width), uint8(y * 255 / height), 120, 255})
+		}
+	}
+	var buf bytes.Buffer
+	c.Goblin.Assert(jpeg.Encode(&buf, img, nil)).IsNil()
+	data := buf.Bytes()
+	if orientation <= 1 {
+		return data
+	}
+
+	// An APP1 segment holding a big-endian TIFF header with a single orientation entry
+	exif := append([]byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x01\x01\x12\x00\x03\x00\x00\x00\x01\x00"), byte(orientation), 0, 0, 0, 0, 0, 0)
+	segment := append([]byte{0xFF, 0xE1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}, exif...)
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+// makeUploadReq posts the file as the multipart field "file" to /uploads
+func makeUploadReq(c *Container, filename string, data []byte, cookies []*http.Cookie) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if data != nil {
+		part, err := form.CreateFormFile("file", filename)
+		c.Goblin.Assert(err).IsNil()
+		part.Write(data)
+	}
+	form.Close()
+
+	req, _ := http.NewRequest("POST", "/api/v1/uploads", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	w := httptest.NewRecorder()
+	c.Router.ServeHTTP(w, req)
+	return w
+}
+
+func uploadWithAPI(c *Container, filename string, data []byte, cookies []*http.Cookie) map[string]interface{} {
+	result := makeUploadReq(c, filename, data, cookies)
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	return response
+}
+
+// getUpload fetches an uploaded file by its URL
+func getUpload(c *Container, url string, header http.Header) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", url, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	c.Router.ServeHTTP(w, req)
+	return w
+}
+
+// testUploads tests /uploads
+func testUploads(c *Container) {
+	var cookies []*http.Cookie
+	var image []byte
+	var url string
+
+	c.Goblin.Before(func() {
+		cookies = createTestUserAndLogin(c, "test-upload@test.com", "test-pwd")
+		image = testPNG(c, 10)
+	})
+
+	c.Goblin.It("POST /uploads should store an image and return its URL", func() {
+		response := uploadWithAPI(c, "cover.png", image, cookies)
+		url = response["url"].(string)
+		c.Goblin.Assert(strings.HasPrefix(url, "/api/v1/uploads/")).IsTrue()
+		c.Goblin.Assert(strings.HasSuffix(url, ".png")).IsTrue()
+		c.Goblin.Assert(response["content_type"]).Eql("image/png")
+		c.Goblin.Assert(response["width"]).Eql(float64(4))
+		c.Goblin.Assert(response["height"]).Eql(float64(4))
+		c.Goblin.Assert(len(response["variants"].([]interface{}))).Eql(0)
+	})
+
+	c.Goblin.It("POST /uploads with the same image should return the same upload", func() {
+		response := uploadWithAPI(c, "copy.png", image, cookies)
+		c.Goblin.Assert(response["url"]).Eql(url)
+
+		uploads := makeValidReq(c, "GET", "/uploads", nil, cookies)
+		c.Goblin.Assert(len(uploads["uploads"].([]interface{}))).Eql(1)
+		c.Goblin.Assert(uploads["used"]).Eql(uploads["uploads"].([]interface{})[0].(map[string]interface{})["size"])
+		c.Goblin.Assert(uploads["quota"]).Eql(float64(c.Env.UploadQuotaBytes))
+	})
+
+	c.Goblin.It("GET /uploads/:name should serve the image with immutable caching", func() {
+		result := getUpload(c, url, nil)
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+		decoded, format, err := imageDecode(result.Body.Bytes())
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(format).Eql("png")
+		c.Goblin.Assert(decoded.Bounds().Dx()).Eql(4)
+		c.Goblin.Assert(result.Header().Get("Content-Type")).Eql("image/png")
+		c.Goblin.Assert(strings.Contains(result.Header().Get("Cache-Control"), "immutable")).IsTrue()
+		c.Goblin.Assert(result.Header().Get("X-Content-Type-Options")).Eql("nosniff")
+
+		cached := getUpload(c, url, http.Header{"If-None-Match": {result.Header().Get("ETag")}})
+		c.Goblin.Assert(cached.Code).Eql(http.StatusNotModified)
+		c.Goblin.Assert(cached.Body.Len()).Eql(0)
+	})
+
+	c.Goblin.It("POST /uploads should sniff the type instead of trusting the file name", func() {
+		response := uploadWithAPI(c, "photo.jpg", testPNG(c, 20), cookies)
+		c.Goblin.Assert(response["content_type"]).Eql("image/png")
+		c.Goblin.Assert(strings.HasSuffix(response["url"].(string), ".png")).IsTrue()
+	})
+
+	c.Goblin.It("POST /uploads should apply the EXIF orientation and strip the metadata", func() {
+		response := uploadWithAPI(c, "portrait.jpg", testJPEG(c, 800, 400, 6), cookies)
+		c.Goblin.Assert(response["content_type"]).Eql("image/jpeg")
+		c.Goblin.Assert(response["width"]).Eql(float64(400))
+		c.Goblin.Assert(response["height"]).Eql(float64(800))
+
+		result := getUpload(c, response["url"].(string), nil)
+		c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+		c.Goblin.Assert(bytes.Contains(result.Body.Bytes(), []byte("Exif"))).IsFalse()
+		decoded, _, err := imageDecode(result.Body.Bytes())
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(decoded.Bounds().Dx()).Eql(400)
+	})
+
+	c.Goblin.It("POST /uploads should return the narrower variants with a srcset and blur hash", func() {
+		response := uploadWithAPI(c, "wide.jpg", testJPEG(c, 800, 400, 1), cookies)
+		c.Goblin.Assert(len(response["blur_hash"].(string))).Eql(28)
+
+		expected := 0
+		for _, width := range c.Env.ImageVariantWidths {
+			if width < 800 {
+				expected++
+			}
+		}
+		variants := response["variants"].([]interface{})
+		c.Goblin.Assert(len(variants)).Eql(expected)
+		c.Goblin.Assert(strings.HasSuffix(response["srcset"].(string), response["url"].(string)+" 800w")).IsTrue()
+
+		for _, v := range variants {
+			variant := v.(map[string]interface{})
+			width := int(variant["width"].(float64))
+			c.Goblin.Assert(variant["height"]).Eql(float64(width / 2))
+			c.Goblin.Assert(strings.Contains(response["srcset"].(string), variant["url"].(string))).IsTrue()
+
+			result := getUpload(c, variant["url"].(string), nil)
+			c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+			decoded, format, err := imageDecode(result.Body.Bytes())
+			c.Goblin.Assert(err).IsNil()
+			c.Goblin.Assert(format).Eql("jpeg")
+			c.Goblin.Assert(decoded.Bounds().Dx()).Eql(width)
+		}
+	})
+}
+
+func imageDecode(data []byte) (image.Image, string, error) {
+	return image.Decode(bytes.NewReader(data))
+}
+
+// fakeS3 is an in-memory stand-in for an S3-compatible server
+// that rejects requests without a SigV4 signature
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case "PUT":
+		data, _ := ioutil.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+	case "GET":
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case "DELETE":
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// testS3Store tests the S3 blob store against a fake server
+func testS3Store(c *Container) {
+	c.Goblin.It("should put, get and delete blobs in the bucket", func() {
+		server := httptest.NewServer(&fakeS3{objects: map[string][]byte{}})
+		defer server.Close()
+
+		store, err := storage.New(storage.Config{
+			Backend: "s3",
+			S3: storage.S3Config{
+				Endpoint:  server.URL,
+				Region:    "us-east-1",
+				Bucket:    "media",
+				AccessKey: "test-key",
+				SecretKey: "test-secret",
+			},
+		})
+		c.Goblin.Assert(err).IsNil()
+
+		ctx := context.Background()
+		c.Goblin.Assert(store.Put(ctx, "images/ab/abc", []byte("blob"), "image/png")).IsNil()
+
+		blob, err := store.Get(ctx, "images/ab/abc")
+		c.Goblin.Assert(err).IsNil()
+		data, _ := ioutil.ReadAll(blob)
+		blob.Close()
+		c.Goblin.Assert(string(data)).Eql("blob")
+
+		c.Goblin.Assert(store.Delete(ctx, "images/ab/abc")).IsNil()
+		_, err = store.Get(ctx, "images/ab/abc")
+		c.Goblin.Assert(err).Eql(storage.ErrNotFound)
+	})
+}
+
+// uploadHash returns the hash in the URL of an upload
+func uploadHash(url string) string {
+	name := url[strings.LastIndex(url, "/")+1:]
+	return name[:64]
+}
+
+// backdateUpload moves the creation of the uploads of an image back by a duration
+func backdateUpload(c *Container, url string, d time.Duration) {
+	_, err := models.Uploads(qm.Where("hash = ?", uploadHash(url))).UpdateAll(c.Context, c.DB, models.M{"created_at": time.Now().Add(-d)})
+	c.Goblin.Assert(err).IsNil()
+}
+
+// orphanedHashes returns the hashes of the uploads in the dry run report of the sweeper
+func orphanedHashes(c *Container, cookies []*http.Cookie) map[string]bool {
+	hashes := map[string]bool{}
+	for _, u := range makeValidReq(c, "GET", "/admin/uploads/orphans", nil, cookies)["uploads"].([]interface{}) {
+		hashes[uploadHash(u.(map[string]interface{})["url"].(string))] = true
+	}
+	return hashes
+}
+
+// testSweepOrphanedUploads tests the references from posts to uploads and the sweeper job
+func testSweepOrphanedUploads(c *Container) {
+	var cookies, adminCookies []*http.Cookie
+	var postID int
+	var first, second, unused map[string]interface{}
+	grace := time.Duration(c.Env.UploadGraceHours) * time.Hour
+
+	c.Goblin.Before(func() {
+		cookies = createTestUserAndLogin(c, "test-sweep@test.com", "test-pwd")
+		adminCookies = createTestUserAndLogin(c, "test-sweep-admin@test.com", "test-pwd")
+		makeAdmin(c, "test-sweep-admin@test.com")
+
+		first = uploadWithAPI(c, "first.jpg", testJPEG(c, 700, 350, 1), cookies)
+		second = uploadWithAPI(c, "second.png", testPNG(c, 90), cookies)
+		unused = uploadWithAPI(c, "unused.jpg", testJPEG(c, 720, 360, 1), cookies)
+		postID = createPostWithAPI(c, Data{"doc": "![first](" + first["url"].(string) + ")"}, cookies)
+	})
+
+	c.Goblin.It("GET /admin/uploads/orphans should list old uploads that no post embeds", func() {
+		backdateUpload(c, first["url"].(string), 2*grace)
+		backdateUpload(c, second["url"].(string), 2*grace)
+		backdateUpload(c, unused["url"].(string), 2*grace)
+
+		orphans := orphanedHashes(c, adminCookies)
+		c.Goblin.Assert(orphans[uploadHash(second["url"].(string))]).IsTrue()
+		c.Goblin.Assert(orphans[uploadHash(unused["url"].(string))]).IsTrue()
+		c.Goblin.Assert(orphans[uploadHash(first["url"].(string))]).IsFalse()
+	})
+
+	c.Goblin.It("PUT /posts should keep the images of earlier revisions referenced", func() {
+		updatePostWithAPI(c, Data{"id": postID, "doc": "![second](" + second["url"].(string) + ")"}, cookies)
+
+		orphans := orphanedHashes(c, adminCookies)
+		c.Goblin.Assert(orphans[uploadHash(first["url"].(string))]).IsFalse()
+		c.Goblin.Assert(orphans[uploadHash(second["url"].(string))]).IsFalse()
+	})
+
+	c.Goblin.It("sweep job should delete the orphaned upload with its variants and keep the embedded ones", func() {
+		hash := uploadHash(unused["url"].(string))
+		variants := unused["variants"].([]interface{})
+		c.Goblin.Assert(len(variants) > 0).IsTrue()
+
+		job := jobs.SweepOrphanedUploads(c.DB, c.Store, grace)
+		c.Goblin.Assert(job.Run(c.Context, time.Now())).IsNil()
+
+		_, err := c.Store.Get(c.Context, storage.ImageKey(hash, 0))
+		c.Goblin.Assert(err).Eql(storage.ErrNotFound)
+		width := int(variants[0].(map[string]interface{})["width"].(float64))
+		_, err = c.Store.Get(c.Context, storage.ImageKey(hash, width))
+		c.Goblin.Assert(err).Eql(storage.ErrNotFound)
+		c.Goblin.Assert(getUpload(c, unused["url"].(string), nil).Code).Eql(http.StatusBadRequest)
+
+		c.Goblin.Assert(getUpload(c, first["url"].(string), nil).Code).Eql(http.StatusOK)
+		c.Goblin.Assert(getUpload(c, second["url"].(string), nil).Code).Eql(http.StatusOK)
+		uploads := makeValidReq(c, "GET", "/uploads", nil, cookies)["uploads"].([]interface{})
+		c.Goblin.Assert(len(uploads)).Eql(2)
+	})
+
+	c.Goblin.It("/:id/revisions/:rev/restore POST should bring back an image removed before the sweep", func() {
+		restored := makeValidReq(c, "POST", fmt.Sprintf("/posts/%d/revisions/1/restore", postID), nil, cookies)
+		c.Goblin.Assert(strings.Contains(restored["doc"].(string), first["url"].(string))).IsTrue()
+		c.Goblin.Assert(getUpload(c, first["url"].(string), nil).Code).Eql(http.StatusOK)
+	})
+
+	c.Goblin.It("sweep job should keep a blob that another user uploaded recently", func() {
+		image := testPNG(c, 100)
+		old := uploadWithAPI(c, "old.png", image, cookies)
+		backdateUpload(c, old["url"].(string), 2*grace)
+		otherCookies := createTestUserAndLogin(c, "test-sweep-other@test.com", "test-pwd")
+		uploadWithAPI(c, "recent.png", image, otherCookies)
+
+		job := jobs.SweepOrphanedUploads(c.DB, c.Store, grace)
+		c.Goblin.Assert(job.Run(c.Context, time.Now())).IsNil()
+		c.Goblin.Assert(getUpload(c, old["url"].(string), nil).Code).Eql(http.StatusOK)
+	})
+
+	c.Goblin.It("PUT /users should keep the image of an avatar from the sweep", func() {
+		avatar := uploadWithAPI(c, "avatar.png", testPNG(c, 110), cookies)
+		backdateUpload(c, avatar["url"].(string), 2*grace)
+		user := getUserFromDBByEmail(c, "test-sweep@test.com")
+		updated := makeValidReq(c, "PUT", "/users", Data{"id": user.ID, "avatar": avatar["url"]}, cookies)
+		c.Goblin.Assert(updated["avatar"]).Eql(avatar["url"])
+
+		c.Goblin.Assert(orphanedHashes(c, adminCookies)[uploadHash(avatar["url"].(string))]).IsFalse()
+		job := jobs.SweepOrphanedUploads(c.DB, c.Store, grace)
+		c.Goblin.Assert(job.Run(c.Context, time.Now())).IsNil()
+		c.Goblin.Assert(getUpload(c, avatar["url"].(string), nil).Code).Eql(http.StatusOK)
+
+		makeValidReq(c, "PUT", "/users", Data{"id": user.ID, "avatar": ""}, cookies)
+		c.Goblin.Assert(orphanedHashes(c, adminCookies)[uploadHash(avatar["url"].(string))]).IsTrue()
+	})
+
+	c.Goblin.It("sweep job should not delete a blob uploaded again after its uploads were swept", func() {
+		image := testPNG(c, 120)
+		swept := uploadWithAPI(c, "swept.png", image, cookies)
+		hash := uploadHash(swept["url"].(string))
+		backdateUpload(c, swept["url"].(string), 2*grace)
+
+		sweep, err := db.SweepOrphanedUploads(c.Context, c.DB, time.Now().Add(-grace))
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(len(sweep.Blobs) > 0).IsTrue()
+
+		uploadWithAPI(c, "again.png", image, cookies)
+		deleted, err := db.DeleteOrphanedBlob(c.Context, c.DB, hash, func() error {
+			return c.Store.Delete(c.Context, storage.ImageKey(hash, 0))
+		})
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(deleted).IsFalse()
+		c.Goblin.Assert(getUpload(c, swept["url"].(string), nil).Code).Eql(http.StatusOK)
+	})
+}
+
+// RunUploadsTests executes tests for image uploads
+func RunUploadsTests(c *Container) {
+	c.Goblin.Describe("Uploads endpoint test", func() {
+		testUploads(c)
+		testUploadsWithInvalidData(c)
+	})
+	c.Goblin.Describe("Upload sweeper test", func() {
+		testSweepOrphanedUploads(c)
+		testSweepWithInvalidUser(c)
+	})
+	c.Goblin.Describe("S3 blob store test", func() {
+		testS3Store(c)
+	})
+}
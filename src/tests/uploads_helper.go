@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+func testUploadsWithInvalidData(c *Container) {
+	assertUploadError := func(filename string, data []byte, cookies []*http.Cookie, code int, msg string) {
+		result := makeUploadReq(c, filename, data, cookies)
+		c.Goblin.Assert(result.Code).Eql(code)
+
+		var response map[string]interface{}
+		c.Goblin.Assert(json.Unmarshal(result.Body.Bytes(), &response)).IsNil()
+		c.Goblin.Assert(response["message"]).Eql(msg)
+	}
+
+	c.Goblin.It("POST /uploads without a file should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-nofile@test.com", "test-pwd")
+		assertUploadError("", nil, cookies, http.StatusBadRequest, "File required.")
+	})
+
+	c.Goblin.It("POST /uploads with a file that is not an image should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-text@test.com", "test-pwd")
+		assertUploadError("notes.png", []byte("just some text"), cookies, http.StatusUnsupportedMediaType, "Unsupported file type.")
+	})
+
+	c.Goblin.It("POST /uploads with a corrupt image should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-corrupt@test.com", "test-pwd")
+		assertUploadError("broken.png", testPNG(c, 60)[:40], cookies, http.StatusBadRequest, "Invalid image.")
+	})
+
+	c.Goblin.It("GET /uploads/:name with an unknown variant should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-variant@test.com", "test-pwd")
+		response := uploadWithAPI(c, "small.png", testPNG(c, 70), cookies)
+		url := strings.TrimPrefix(strings.TrimSuffix(response["url"].(string), ".png"), "/api/v1")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			url + "-w320.png",
+			"Upload not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("POST /uploads with a file over the size limit should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-large@test.com", "test-pwd")
+		large := append(testPNG(c, 30), bytes.Repeat([]byte{0}, c.Env.UploadMaxBytes)...)
+		assertUploadError("large.png", large, cookies, http.StatusRequestEntityTooLarge, "File too large.")
+	})
+
+	c.Goblin.It("POST /uploads over the quota should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-upload-quota@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "test-upload-quota@test.com")
+		filler := models.Upload{
+			UserID:      user.ID,
+			Hash:        strings.Repeat("f", 64),
+			ContentType: "image/png",
+			Size:        int64(c.Env.UploadQuotaBytes),
+		}
+		c.Goblin.Assert(filler.Insert(c.Context, c.DB, boil.Infer())).IsNil()
+
+		assertUploadError("second.png", testPNG(c, 50), cookies, http.StatusBadRequest, "Upload quota exceeded.")
+	})
+
+	c.Goblin.It("GET /uploads/:name with an unknown name should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/uploads/0000000000000000000000000000000000000000000000000000000000000000.png",
+			"Upload not found.",
+			http.StatusBadRequest,
+			nil,
+		})
+	})
+
+	c.Goblin.It("GET /uploads without a login should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/uploads",
+			"Token not found.",
+			http.StatusUnauthorized,
+			nil,
+		})
+	})
+}
+
+func testSweepWithInvalidUser(c *Container) {
+	c.Goblin.It("GET /admin/uploads/orphans by a user who is not an admin should return error", func() {
+		cookies := createTestUserAndLogin(c, "test-sweep-nonadmin@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"GET",
+			"/admin/uploads/orphans",
+			"User is not an admin.",
+			http.StatusForbidden,
+			cookies,
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/json9512/mediumclone-backendwithgo/src/models"
+)
+
+// testUserBlocks tests /users/:id/block to stop a user from mentioning and following the current user
+func testUserBlocks(c *Container) {
+	var writerCookies, bobCookies []*http.Cookie
+	var writerID, bobID int
+
+	c.Goblin.Before(func() {
+		writerCookies = createTestUserAndLogin(c, "block-writer@test.com", "test-pwd")
+		bobCookies = createTestUserAndLogin(c, "block-bob@test.com", "test-pwd")
+		writerID = getUserFromDBByEmail(c, "block-writer@test.com").ID
+		bobID = getUserFromDBByEmail(c, "block-bob@test.com").ID
+	})
+
+	c.Goblin.It("POST /users/:id/block should stop the blocked user from following the user", func() {
+		followUserWithAPI(c, "block-bob@test.com", writerCookies)
+		response := makeValidReq(c, "POST", fmt.Sprintf("/users/%d/block", writerID), nil, bobCookies)
+		c.Goblin.Assert(response["user_id"]).Eql(float64(writerID))
+		c.Goblin.Assert(response["blocking"]).IsTrue()
+
+		following, err := models.UserFollows(qm.Where("follower_id = ? AND followee_id = ?", writerID, bobID)).Exists(c.Context, c.DB)
+		c.Goblin.Assert(err).IsNil()
+		c.Goblin.Assert(following).IsFalse()
+	})
+
+	c.Goblin.It("POST /users/:id/block should stop the blocked user from mentioning the user", func() {
+		makeValidReq(c, "POST", "/notifications/all/read", nil, bobCookies)
+
+		postID := createPostWithAPI(c, Data{"doc": "Still here @block-bob?"}, writerCookies)
+		c.Goblin.Assert(strings.Contains(renderedPost(c, postID, nil), "class=\"mention\"")).IsFalse()
+		unread, _ := getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(0)
+
+		response := makeValidReq(c, "DELETE", fmt.Sprintf("/users/%d/block", writerID), nil, bobCookies)
+		c.Goblin.Assert(response["blocking"]).IsFalse()
+		updatePostWithAPI(c, Data{"id": postID, "doc": "Welcome back @block-bob"}, writerCookies)
+		unread, _ = getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(1)
+	})
+
+	c.Goblin.It("POST /users/:id/block should stop a blocked user with a capitalized name from mentioning the user", func() {
+		shoutyCookies := createTestUserAndLogin(c, "Block-Shouty@test.com", "test-pwd")
+		shoutyID := getUserFromDBByEmail(c, "Block-Shouty@test.com").ID
+		makeValidReq(c, "POST", fmt.Sprintf("/users/%d/block", shoutyID), nil, bobCookies)
+		makeValidReq(c, "POST", "/notifications/all/read", nil, bobCookies)
+
+		postID := createPostWithAPI(c, Data{"doc": "Hello @Block-Bob"}, shoutyCookies)
+		c.Goblin.Assert(strings.Contains(renderedPost(c, postID, nil), "class=\"mention\"")).IsFalse()
+		unread, _ := getNotifications(c, bobCookies)
+		c.Goblin.Assert(unread).Eql(0)
+	})
+}
+
+// RunUserBlocksTests executes all tests for /users/:id/block
+func RunUserBlocksTests(c *Container) {
+	c.Goblin.Describe("API /users/:id/block", func() {
+		// POST, DELETE /users/:id/block
+		testUserBlocks(c)
+		testUserBlocksWithInvalidData(c)
+	})
+}
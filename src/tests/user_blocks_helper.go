@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func testUserBlocksWithInvalidData(c *Container) {
+	c.Goblin.It("POST /users/:id/block without a login should return error", func() {
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			"/users/1/block",
+			"Token not found.",
+			http.StatusUnauthorized,
+			nil,
+		})
+	})
+
+	c.Goblin.It("POST /users/:id/block on the current user should return error", func() {
+		cookies := createTestUserAndLogin(c, "block-self@test.com", "test-pwd")
+		user := getUserFromDBByEmail(c, "block-self@test.com")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"POST",
+			fmt.Sprintf("/users/%d/block", user.ID),
+			"Users cannot block themselves.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+
+	c.Goblin.It("DELETE /users/:id/block with an unknown user should return error", func() {
+		cookies := createTestUserAndLogin(c, "block-unknown@test.com", "test-pwd")
+
+		c.makeInvalidReq(&errorTestCase{
+			nil,
+			"DELETE",
+			"/users/999999/block",
+			"User not found.",
+			http.StatusBadRequest,
+			cookies,
+		})
+	})
+}
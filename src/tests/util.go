@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
@@ -21,6 +22,7 @@ import (
 	"github.com/json9512/mediumclone-backendwithgo/src/db"
 	"github.com/json9512/mediumclone-backendwithgo/src/middlewares"
 	"github.com/json9512/mediumclone-backendwithgo/src/models"
+	"github.com/json9512/mediumclone-backendwithgo/src/storage"
 )
 
 // Data is for structuring req.body/res.body in json format
@@ -33,6 +35,7 @@ type Container struct {
 	DB      *sql.DB
 	Context context.Context
 	Env     *config.EnvVars
+	Store   storage.BlobStore
 }
 
 type reqData struct {
@@ -98,6 +101,77 @@ func createTestUser(c *Container, email, pwd string) *models.User {
 	return createdUser
 }
 
+func createTestUserAndLogin(c *Container, email, pwd string) []*http.Cookie {
+	createTestUser(c, email, pwd)
+	loginResult := login(c, email, pwd)
+	c.Goblin.Assert(loginResult.Code).Eql(http.StatusOK)
+	return loginResult.Result().Cookies()
+}
+
+func createPostWithAPI(c *Container, values Data, cookies []*http.Cookie) int {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  "POST",
+		path:    "/posts",
+		reqBody: &values,
+		cookie:  cookies,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	return int(response["id"].(float64))
+}
+
+// makeValidReq makes a request that is expected to succeed and returns its response
+func makeValidReq(c *Container, method string, path string, values interface{}, cookies []*http.Cookie) map[string]interface{} {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  method,
+		path:    path,
+		reqBody: values,
+		cookie:  cookies,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	return response
+}
+
+// makeValidStatusReq makes a request that is expected to succeed without a response body
+func makeValidStatusReq(c *Container, method string, path string, values interface{}, cookies []*http.Cookie) {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  method,
+		path:    path,
+		reqBody: values,
+		cookie:  cookies,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+}
+
+// makeAdmin gives the user with the email admin rights
+func makeAdmin(c *Container, email string) {
+	_, err := models.Users(qm.Where("email = ?", email)).UpdateAll(c.Context, c.DB, models.M{"is_admin": true})
+	c.Goblin.Assert(err).IsNil()
+}
+
+func updatePostWithAPI(c *Container, values Data, cookies []*http.Cookie) map[string]interface{} {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  "PUT",
+		path:    "/posts",
+		reqBody: &values,
+		cookie:  cookies,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+
+	response, err := extractResult(result)
+	c.Goblin.Assert(err).IsNil()
+	return response
+}
+
 func getUserFromDBByEmail(c *Container, email string) *models.User {
 	testUser, err := models.Users(qm.Where("email = ?", email)).One(c.Context, c.DB)
 	c.Goblin.Assert(err).IsNil()
@@ -110,6 +184,28 @@ func getUserFromDBByID(c *Container, id int) *models.User {
 	return testUser
 }
 
+func getPostFromDBByID(c *Container, id int) *models.Post {
+	post, err := models.Posts(qm.Where("id = ?", id)).One(c.Context, c.DB)
+	c.Goblin.Assert(err).IsNil()
+	return post
+}
+
+// findPostInDB looks up a post by its ID including posts in the trash
+func findPostInDB(c *Container, id int) (*models.Post, error) {
+	return models.Posts(qm.WithDeleted(), qm.Where("id = ?", id)).One(c.Context, c.DB)
+}
+
+func deletePostWithAPI(c *Container, id int, cookies []*http.Cookie) {
+	result := MakeRequest(&reqData{
+		handler: c.Router,
+		method:  "DELETE",
+		path:    fmt.Sprintf("/posts/%d", id),
+		reqBody: nil,
+		cookie:  cookies,
+	})
+	c.Goblin.Assert(result.Code).Eql(http.StatusOK)
+}
+
 func extractBody(h *httptest.ResponseRecorder) map[string]interface{} {
 	var response map[string]interface{}
 	_ = json.Unmarshal(h.Body.Bytes(), &response)